@@ -6,6 +6,9 @@ package compiler
 
 import (
 	"fmt"
+	"math"
+	"slices"
+	"strings"
 
 	"gopkg.microglot.org/mglotc/internal/exc"
 	"gopkg.microglot.org/mglotc/internal/idl"
@@ -25,14 +28,52 @@ func check(image *idl.Image, reporter exc.Reporter) {
 type imageChecker struct {
 	image    *idl.Image
 	reporter exc.Reporter
+	// module is the module currently being checked, which is where any
+	// reported locations point to.
+	module *proto.Module
+	// extends indexes the APIs and SDKs of the image by their references.
+	extends *extendsGraph
+	// extensions records the annotation or extension that first used each
+	// number of each protobuf options message or extended struct.
+	extensions map[extensionKey]*extension
 }
 
-func (c *imageChecker) lookup(tr *proto.TypeReference) (idl.TypeKind, interface{}) {
+// extensionKey identifies a number of an extendee, which is either the full
+// name of a protobuf options message or the extendableKey of a struct.
+type extensionKey struct {
+	extendee string
+	number   int32
+}
+
+type extension struct {
+	// name describes the annotation or extension, such as "annotation Tag".
+	name   string
+	module *proto.Module
+}
+
+// claimExtension records that the named annotation or extension uses the
+// number of the key, and returns a description of the one that already did,
+// if any.
+func (c *imageChecker) claimExtension(key extensionKey, name string) (string, bool) {
+	existing, ok := c.extensions[key]
+	if !ok {
+		c.extensions[key] = &extension{name, c.module}
+		return "", false
+	}
+	if existing.module != c.module {
+		return fmt.Sprintf("%s (%s)", existing.name, existing.module.URI), true
+	}
+	return existing.name, true
+}
+
+func (c *imageChecker) location(location *proto.SourceLocation) exc.Location {
+	return sourceLocation(c.module.URI, location)
+}
+
+func (c *imageChecker) lookup(tr *proto.TypeReference, location *proto.SourceLocation) (idl.TypeKind, interface{}) {
 	kind, declaration := c.image.Lookup(tr)
 	if kind == idl.TypeKindError {
-		c.reporter.Report(exc.New(exc.Location{
-			// TODO 2023.11.26: location?
-		}, exc.CodeUnknownReference, fmt.Sprintf("Resolved reference (ModuleUID=%d, TypeUID=%d) points to a type outside the current Image", tr.ModuleUID, tr.TypeUID)))
+		c.reporter.Report(exc.New(c.location(location), exc.CodeUnknownReference, fmt.Sprintf("Resolved reference (ModuleUID=%d, TypeUID=%d) points to a type outside the current Image", tr.ModuleUID, tr.TypeUID)))
 	}
 	return kind, declaration
 }
@@ -40,11 +81,9 @@ func (c *imageChecker) lookup(tr *proto.TypeReference) (idl.TypeKind, interface{
 func (c *imageChecker) checkTypeSpecifier(ts *proto.TypeSpecifier, expectedKinds []idl.TypeKind) {
 	resolved, ok := ts.Reference.(*proto.TypeSpecifier_Resolved)
 	if !ok {
-		c.reporter.Report(exc.New(exc.Location{
-			// TODO 2023.11.26: location?
-		}, exc.CodeUnresolvedReference, fmt.Sprintf("Unexpected unresolved reference while type checking")))
+		c.reporter.Report(exc.New(c.location(ts.Location), exc.CodeUnresolvedReference, fmt.Sprintf("Unexpected unresolved reference while type checking")))
 	} else {
-		kind, declaration := c.lookup(resolved.Resolved.Reference)
+		kind, declaration := c.lookup(resolved.Resolved.Reference, ts.Location)
 		for _, expectedKind := range expectedKinds {
 			if kind == expectedKind {
 				var typeName *proto.TypeName = nil
@@ -61,14 +100,17 @@ func (c *imageChecker) checkTypeSpecifier(ts *proto.TypeSpecifier, expectedKinds
 
 				if len(resolved.Resolved.Parameters) > 0 {
 					if typeName == nil {
-						c.reporter.Report(exc.New(exc.Location{}, exc.CodeTypeParameterError, fmt.Sprintf("type can't be parameterized")))
+						c.reporter.Report(exc.New(c.location(ts.Location), exc.CodeTypeParameterError, fmt.Sprintf("type can't be parameterized")))
 					} else {
 						if len(typeName.Parameters) != len(resolved.Resolved.Parameters) {
-							c.reporter.Report(exc.New(exc.Location{}, exc.CodeTypeParameterError, fmt.Sprintf("wrong number of parameters")))
+							c.reporter.Report(exc.New(c.location(ts.Location), exc.CodeTypeParameterError, fmt.Sprintf("wrong number of parameters")))
 						} else {
 							for _, parameter := range resolved.Resolved.Parameters {
 								c.checkTypeSpecifier(parameter, []idl.TypeKind{idl.TypeKindPrimitive, idl.TypeKindData, idl.TypeKindVirtual, idl.TypeKindStruct, idl.TypeKindEnum, idl.TypeKindAPI, idl.TypeKindSDK})
 							}
+							if kind == idl.TypeKindVirtual {
+								c.checkVirtualType(typeName.Name, resolved.Resolved.Parameters)
+							}
 						}
 					}
 				}
@@ -76,12 +118,57 @@ func (c *imageChecker) checkTypeSpecifier(ts *proto.TypeSpecifier, expectedKinds
 				return
 			}
 		}
-		c.reporter.Report(exc.New(exc.Location{
-			// TODO 2023.11.26: location?
-		}, exc.CodeWrongTypeKind, fmt.Sprintf("unexpected %d (expecting %v)", kind, expectedKinds)))
+		c.reporter.Report(exc.New(c.location(ts.Location), exc.CodeWrongTypeKind, fmt.Sprintf("unexpected %d (expecting %v)", kind, expectedKinds)))
+	}
+}
+
+// checkVirtualType reports the parameters of a List, Map, or Presence that it
+// can't hold. Lists and the values of maps can't be lists or maps, the keys of
+// maps must be Bool, Text, or integers, and presences must be of scalar, enum,
+// or struct types.
+func (c *imageChecker) checkVirtualType(name string, parameters []*proto.TypeSpecifier) {
+	switch name {
+	case "List":
+		if kind, parameterName := c.parameterType(parameters[0]); kind == idl.TypeKindVirtual && parameterName != "Presence" {
+			c.reporter.Report(exc.New(c.location(parameters[0].Location), exc.CodeInvalidListElement, fmt.Sprintf("List can't hold a %s", parameterName)))
+		}
+	case "Map":
+		if kind, parameterName := c.parameterType(parameters[0]); kind != idl.TypeKindError && (kind != idl.TypeKindPrimitive || parameterName == "Float32" || parameterName == "Float64") {
+			c.reporter.Report(exc.New(c.location(parameters[0].Location), exc.CodeInvalidMapKey, fmt.Sprintf("Map keys can't be %s (expecting Bool, Text, or an integer type)", parameterName)))
+		}
+		if kind, parameterName := c.parameterType(parameters[1]); kind == idl.TypeKindVirtual && parameterName != "Presence" {
+			c.reporter.Report(exc.New(c.location(parameters[1].Location), exc.CodeInvalidMapValue, fmt.Sprintf("Map values can't be %s", parameterName)))
+		}
+	case "Presence":
+		switch kind, parameterName := c.parameterType(parameters[0]); kind {
+		case idl.TypeKindError, idl.TypeKindPrimitive, idl.TypeKindData, idl.TypeKindEnum, idl.TypeKindStruct:
+		default:
+			c.reporter.Report(exc.New(c.location(parameters[0].Location), exc.CodeInvalidPresenceType, fmt.Sprintf("Presence can't hold %s (expecting a scalar, enum, or struct type)", parameterName)))
+		}
 	}
 }
 
+// parameterType returns the kind and name of the type of a type parameter. It
+// returns TypeKindError for parameters that checkTypeSpecifier reports.
+func (c *imageChecker) parameterType(ts *proto.TypeSpecifier) (idl.TypeKind, string) {
+	resolved, ok := ts.Reference.(*proto.TypeSpecifier_Resolved)
+	if !ok {
+		return idl.TypeKindError, ""
+	}
+	kind, declaration := c.image.Lookup(resolved.Resolved.Reference)
+	switch d := declaration.(type) {
+	case *proto.Struct:
+		return kind, d.Name.Name
+	case *proto.Enum:
+		return kind, d.Name
+	case *proto.API:
+		return kind, d.Name.Name
+	case *proto.SDK:
+		return kind, d.Name.Name
+	}
+	return idl.TypeKindError, ""
+}
+
 // additional typechecks for structs used as API input/output
 func (c *imageChecker) checkTypeSpecifierForAPI(ts *proto.TypeSpecifier) {
 	var stack []*proto.TypeSpecifier_Resolved
@@ -100,7 +187,7 @@ func (c *imageChecker) checkTypeSpecifierForAPI(ts *proto.TypeSpecifier) {
 		}
 		seen[key] = true
 
-		kind, declaration := c.lookup(current.Resolved.Reference)
+		kind, declaration := c.lookup(current.Resolved.Reference, ts.Location)
 		switch kind {
 		case idl.TypeKindStruct:
 			struct_ := declaration.(*proto.Struct)
@@ -111,9 +198,7 @@ func (c *imageChecker) checkTypeSpecifierForAPI(ts *proto.TypeSpecifier) {
 				}
 			}
 		case idl.TypeKindAPI, idl.TypeKindSDK:
-			c.reporter.Report(exc.New(exc.Location{
-				// TODO 2023.11.26: location?
-			}, exc.CodeWrongTypeForAPI, fmt.Sprintf("structs which transitively include API, SDK or Impl fields can't be passed as API method input or output")))
+			c.reporter.Report(exc.New(c.location(ts.Location), exc.CodeWrongTypeForAPI, fmt.Sprintf("structs which transitively include API, SDK or Impl fields can't be passed as API method input or output")))
 		}
 
 		for _, parameter := range current.Resolved.Parameters {
@@ -132,81 +217,55 @@ func (c *imageChecker) checkValuePrimitive(value *proto.Value, context *proto.St
 	switch value.Kind.(type) {
 	case *proto.Value_Bool:
 		if primitiveTypeName != "Bool" {
-			c.reporter.Report(exc.New(exc.Location{
-				// TODO 2023.12.12: location?
-			}, exc.CodeWrongTypeValue, fmt.Sprintf("expecting %s, found boolean", primitiveTypeName)))
+			c.reporter.Report(exc.New(c.location(value.Location), exc.CodeWrongTypeValue, fmt.Sprintf("expecting %s, found boolean", primitiveTypeName)))
 		}
 	case *proto.Value_Text:
 		if primitiveTypeName != "Text" {
-			c.reporter.Report(exc.New(exc.Location{
-				// TODO 2023.12.12: location?
-			}, exc.CodeWrongTypeValue, fmt.Sprintf("expecting %s, found text", primitiveTypeName)))
+			c.reporter.Report(exc.New(c.location(value.Location), exc.CodeWrongTypeValue, fmt.Sprintf("expecting %s, found text", primitiveTypeName)))
 		}
 	case *proto.Value_Int8:
 		if primitiveTypeName != "Int8" && primitiveTypeName != "Int16" && primitiveTypeName != "Int32" && primitiveTypeName != "Int64" {
-			c.reporter.Report(exc.New(exc.Location{
-				// TODO 2023.12.12: location?
-			}, exc.CodeWrongTypeValue, fmt.Sprintf("expecting %s, found int8", primitiveTypeName)))
+			c.reporter.Report(exc.New(c.location(value.Location), exc.CodeWrongTypeValue, fmt.Sprintf("expecting %s, found int8", primitiveTypeName)))
 		}
 	case *proto.Value_Int16:
 		if primitiveTypeName != "Int16" && primitiveTypeName != "Int32" && primitiveTypeName != "Int64" {
-			c.reporter.Report(exc.New(exc.Location{
-				// TODO 2023.12.12: location?
-			}, exc.CodeWrongTypeValue, fmt.Sprintf("expecting %s, found int16", primitiveTypeName)))
+			c.reporter.Report(exc.New(c.location(value.Location), exc.CodeWrongTypeValue, fmt.Sprintf("expecting %s, found int16", primitiveTypeName)))
 		}
 	case *proto.Value_Int32:
 		if primitiveTypeName != "Int32" && primitiveTypeName != "Int64" {
-			c.reporter.Report(exc.New(exc.Location{
-				// TODO 2023.12.12: location?
-			}, exc.CodeWrongTypeValue, fmt.Sprintf("expecting %s, found int32", primitiveTypeName)))
+			c.reporter.Report(exc.New(c.location(value.Location), exc.CodeWrongTypeValue, fmt.Sprintf("expecting %s, found int32", primitiveTypeName)))
 		}
 	case *proto.Value_Int64:
 		if primitiveTypeName != "Int64" {
-			c.reporter.Report(exc.New(exc.Location{
-				// TODO 2023.12.12: location?
-			}, exc.CodeWrongTypeValue, fmt.Sprintf("expecting %s, found int64", primitiveTypeName)))
+			c.reporter.Report(exc.New(c.location(value.Location), exc.CodeWrongTypeValue, fmt.Sprintf("expecting %s, found int64", primitiveTypeName)))
 		}
 	case *proto.Value_UInt8:
 		if primitiveTypeName != "UInt8" && primitiveTypeName != "UInt16" && primitiveTypeName != "UInt32" && primitiveTypeName != "UInt64" && primitiveTypeName != "Int16" && primitiveTypeName != "Int32" && primitiveTypeName != "Int64" {
-			c.reporter.Report(exc.New(exc.Location{
-				// TODO 2023.12.12: location?
-			}, exc.CodeWrongTypeValue, fmt.Sprintf("expecting %s, found uint8", primitiveTypeName)))
+			c.reporter.Report(exc.New(c.location(value.Location), exc.CodeWrongTypeValue, fmt.Sprintf("expecting %s, found uint8", primitiveTypeName)))
 		}
 	case *proto.Value_UInt16:
 		if primitiveTypeName != "UInt16" && primitiveTypeName != "UInt32" && primitiveTypeName != "UInt64" && primitiveTypeName != "Int32" && primitiveTypeName != "Int64" {
-			c.reporter.Report(exc.New(exc.Location{
-				// TODO 2023.12.12: location?
-			}, exc.CodeWrongTypeValue, fmt.Sprintf("expecting %s, found uint16", primitiveTypeName)))
+			c.reporter.Report(exc.New(c.location(value.Location), exc.CodeWrongTypeValue, fmt.Sprintf("expecting %s, found uint16", primitiveTypeName)))
 		}
 	case *proto.Value_UInt32:
 		if primitiveTypeName != "UInt32" && primitiveTypeName != "UInt64" && primitiveTypeName != "Int64" {
-			c.reporter.Report(exc.New(exc.Location{
-				// TODO 2023.12.12: location?
-			}, exc.CodeWrongTypeValue, fmt.Sprintf("expecting %s, found uint32", primitiveTypeName)))
+			c.reporter.Report(exc.New(c.location(value.Location), exc.CodeWrongTypeValue, fmt.Sprintf("expecting %s, found uint32", primitiveTypeName)))
 		}
 	case *proto.Value_UInt64:
 		if primitiveTypeName != "UInt64" {
-			c.reporter.Report(exc.New(exc.Location{
-				// TODO 2023.12.12: location?
-			}, exc.CodeWrongTypeValue, fmt.Sprintf("expecting %s, found uint64", primitiveTypeName)))
+			c.reporter.Report(exc.New(c.location(value.Location), exc.CodeWrongTypeValue, fmt.Sprintf("expecting %s, found uint64", primitiveTypeName)))
 		}
 	case *proto.Value_Float32:
 		if primitiveTypeName != "Float32" && primitiveTypeName != "Float64" {
-			c.reporter.Report(exc.New(exc.Location{
-				// TODO 2023.12.12: location?
-			}, exc.CodeWrongTypeValue, fmt.Sprintf("expecting %s, found float32", primitiveTypeName)))
+			c.reporter.Report(exc.New(c.location(value.Location), exc.CodeWrongTypeValue, fmt.Sprintf("expecting %s, found float32", primitiveTypeName)))
 		}
 	case *proto.Value_Float64:
 		if primitiveTypeName != "Float64" {
-			c.reporter.Report(exc.New(exc.Location{
-				// TODO 2023.12.12: location?
-			}, exc.CodeWrongTypeValue, fmt.Sprintf("expecting %s, found float64", primitiveTypeName)))
+			c.reporter.Report(exc.New(c.location(value.Location), exc.CodeWrongTypeValue, fmt.Sprintf("expecting %s, found float64", primitiveTypeName)))
 		}
 
 	default:
-		c.reporter.Report(exc.New(exc.Location{
-			// TODO 2023.12.12: location?
-		}, exc.CodeWrongTypeValue, fmt.Sprintf("expecting %s, found %s", primitiveTypeName, value)))
+		c.reporter.Report(exc.New(c.location(value.Location), exc.CodeWrongTypeValue, fmt.Sprintf("expecting %s, found %s", primitiveTypeName, value)))
 	}
 }
 
@@ -215,9 +274,7 @@ func (c *imageChecker) checkValueData(value *proto.Value) {
 	switch value.Kind.(type) {
 	case *proto.Value_Data:
 	default:
-		c.reporter.Report(exc.New(exc.Location{
-			// TODO 2023.12.12: location?
-		}, exc.CodeWrongTypeValue, fmt.Sprintf("expecting Data, found %s", value.Kind)))
+		c.reporter.Report(exc.New(c.location(value.Location), exc.CodeWrongTypeValue, fmt.Sprintf("expecting Data, found %s", value.Kind)))
 	}
 }
 
@@ -229,12 +286,58 @@ func (c *imageChecker) checkValueList(value *proto.Value, expectedTypeSpecifier
 			c.checkValue(element, expectedTypeSpecifier)
 		}
 	default:
-		c.reporter.Report(exc.New(exc.Location{
-			// TODO 2023.12.12: location?
-		}, exc.CodeWrongTypeValue, fmt.Sprintf("expecting List, found %s", value.Kind)))
+		c.reporter.Report(exc.New(c.location(value.Location), exc.CodeWrongTypeValue, fmt.Sprintf("expecting List, found %s", value.Kind)))
 	}
 }
 
+// typecheck a value used in a Map context
+func (c *imageChecker) checkValueMap(value *proto.Value, expectedKeyTypeSpecifier *proto.TypeSpecifier, expectedValueTypeSpecifier *proto.TypeSpecifier) {
+	switch map_ := value.Kind.(type) {
+	case *proto.Value_Map:
+		keys := make(map[string]bool, len(map_.Map.Entries))
+		for _, entry := range map_.Map.Entries {
+			c.checkValue(entry.Key, expectedKeyTypeSpecifier)
+			c.checkValue(entry.Value, expectedValueTypeSpecifier)
+			if key, ok := mapKey(entry.Key); ok {
+				if keys[key] {
+					c.reporter.Report(exc.New(c.location(entry.Key.Location), exc.CodeInvalidMapKey, fmt.Sprintf("map literal has the key %s more than once", key)))
+				}
+				keys[key] = true
+			}
+		}
+	default:
+		c.reporter.Report(exc.New(c.location(value.Location), exc.CodeWrongTypeValue, fmt.Sprintf("expecting Map, found %s", value.Kind)))
+	}
+}
+
+// mapKey returns the value of a map key as it is written, or false if the value
+// isn't a literal that a map key can be.
+func mapKey(value *proto.Value) (string, bool) {
+	switch kind := value.Kind.(type) {
+	case *proto.Value_Bool:
+		return fmt.Sprint(kind.Bool.Value), true
+	case *proto.Value_Text:
+		return fmt.Sprintf("%q", kind.Text.Value), true
+	case *proto.Value_Int8:
+		return fmt.Sprint(kind.Int8.Value), true
+	case *proto.Value_Int16:
+		return fmt.Sprint(kind.Int16.Value), true
+	case *proto.Value_Int32:
+		return fmt.Sprint(kind.Int32.Value), true
+	case *proto.Value_Int64:
+		return fmt.Sprint(kind.Int64.Value), true
+	case *proto.Value_UInt8:
+		return fmt.Sprint(kind.UInt8.Value), true
+	case *proto.Value_UInt16:
+		return fmt.Sprint(kind.UInt16.Value), true
+	case *proto.Value_UInt32:
+		return fmt.Sprint(kind.UInt32.Value), true
+	case *proto.Value_UInt64:
+		return fmt.Sprint(kind.UInt64.Value), true
+	}
+	return "", false
+}
+
 // typecheck a value used in a Presence context
 func (c *imageChecker) checkValuePresence(value *proto.Value, expectedTypeSpecifier *proto.TypeSpecifier) {
 	c.checkValue(value, expectedTypeSpecifier)
@@ -243,9 +346,7 @@ func (c *imageChecker) checkValuePresence(value *proto.Value, expectedTypeSpecif
 // typecheck a value used in a Struct context
 func (c *imageChecker) checkValueStruct(value *proto.Value, context *proto.Struct, parameters []*proto.TypeSpecifier) {
 	if parameters != nil {
-		c.reporter.Report(exc.New(exc.Location{
-			// TODO 2023.12.12: location?
-		}, exc.CodeUnimplemented, fmt.Sprintf("parameterized struct literals aren't supported yet")))
+		c.reporter.Report(exc.New(c.location(value.Location), exc.CodeUnimplemented, fmt.Sprintf("parameterized struct literals aren't supported yet")))
 		return
 	}
 
@@ -261,26 +362,34 @@ func (c *imageChecker) checkValueStruct(value *proto.Value, context *proto.Struc
 				}
 			}
 			if !found {
-				c.reporter.Report(exc.New(exc.Location{
-					// TODO 2023.12.12: location?
-				}, exc.CodeUnknownFieldInStructLiteral, fmt.Sprintf("struct %s literal has unknown field: %s", context.Name.Name, valueStructField.Name)))
+				c.reporter.Report(exc.New(c.location(value.Location), exc.CodeUnknownFieldInStructLiteral, fmt.Sprintf("struct %s literal has unknown field: %s", context.Name.Name, valueStructField.Name)))
 			}
 		}
 	default:
-		c.reporter.Report(exc.New(exc.Location{
-			// TODO 2023.12.12: location?
-		}, exc.CodeWrongTypeValue, fmt.Sprintf("expecting Struct, found %s", value.Kind)))
+		c.reporter.Report(exc.New(c.location(value.Location), exc.CodeWrongTypeValue, fmt.Sprintf("expecting Struct, found %s", value.Kind)))
+	}
+}
+
+// typecheck a value used in an Enum context
+func (c *imageChecker) checkValueEnum(value *proto.Value, context *proto.Enum) {
+	var enumerant *proto.AttributeReference
+	switch kind := value.Kind.(type) {
+	case *proto.Value_Enumerant:
+		enumerant = kind.Enumerant
+	case *proto.Value_Identifier:
+		enumerant = kind.Identifier.GetAttribute()
+	}
+	if enumerant == nil || enumerant.ModuleUID != context.Reference.ModuleUID || enumerant.TypeUID != context.Reference.TypeUID {
+		c.reporter.Report(exc.New(c.location(value.Location), exc.CodeWrongTypeValue, fmt.Sprintf("expecting a value of enum %s, found %s", context.Name, value.Kind)))
 	}
 }
 
 func (c *imageChecker) checkValue(value *proto.Value, expectedTypeSpecifier *proto.TypeSpecifier) {
 	resolved, ok := expectedTypeSpecifier.Reference.(*proto.TypeSpecifier_Resolved)
 	if !ok {
-		c.reporter.Report(exc.New(exc.Location{
-			// TODO 2023.12.12: location?
-		}, exc.CodeUnresolvedReference, fmt.Sprintf("Unexpected unresolved reference while type checking")))
+		c.reporter.Report(exc.New(c.location(value.Location), exc.CodeUnresolvedReference, fmt.Sprintf("Unexpected unresolved reference while type checking")))
 	} else {
-		expectedKind, expectedDeclaration := c.lookup(resolved.Resolved.Reference)
+		expectedKind, expectedDeclaration := c.lookup(resolved.Resolved.Reference, value.Location)
 
 		switch expectedKind {
 		case idl.TypeKindPrimitive:
@@ -293,53 +402,86 @@ func (c *imageChecker) checkValue(value *proto.Value, expectedTypeSpecifier *pro
 				c.checkValueList(value, resolved.Resolved.Parameters[0])
 			} else if virtualTypeName == "Presence" {
 				c.checkValuePresence(value, resolved.Resolved.Parameters[0])
+			} else if virtualTypeName == "Map" {
+				c.checkValueMap(value, resolved.Resolved.Parameters[0], resolved.Resolved.Parameters[1])
 			} else {
-				c.reporter.Report(exc.New(exc.Location{
-					// TODO 2023.12.12: location?
-				}, exc.CodeUnknownFatal, fmt.Sprintf("unknown virtual type %s (can't happen!)", virtualTypeName)))
+				c.reporter.Report(exc.New(c.location(value.Location), exc.CodeUnknownFatal, fmt.Sprintf("unknown virtual type %s (can't happen!)", virtualTypeName)))
 			}
 		case idl.TypeKindStruct:
 			c.checkValueStruct(value, expectedDeclaration.(*proto.Struct), resolved.Resolved.Parameters)
+		case idl.TypeKindEnum:
+			c.checkValueEnum(value, expectedDeclaration.(*proto.Enum))
 		default:
-			c.reporter.Report(exc.New(exc.Location{
-				// TODO 2023.12.12: location?
-			}, exc.CodeUnimplemented, fmt.Sprintf("expecting a %d, which isn't supported by the language", expectedKind)))
+			c.reporter.Report(exc.New(c.location(value.Location), exc.CodeUnimplemented, fmt.Sprintf("expecting a %d, which isn't supported by the language", expectedKind)))
 		}
 	}
 }
 
 func (c *imageChecker) check() {
+	c.extends = newExtendsGraph(c.image)
+	c.extensions = make(map[extensionKey]*extension)
 	for _, module := range c.image.Modules {
+		c.module = module
 		// TODO 2023.11.26: DotImport.Reference?
-		c.checkAnnotationApplications(module.AnnotationApplications)
+		c.checkAnnotationApplications(module.AnnotationApplications, proto.AnnotationScope_AnnotationScopeModule)
+		c.checkProtobufSyntax(module)
+		c.checkStructExtensions(module.Extensions)
 		for _, struct_ := range module.Structs {
-			c.checkAnnotationApplications(struct_.AnnotationApplications)
+			c.checkAnnotationApplications(struct_.AnnotationApplications, proto.AnnotationScope_AnnotationScopeStruct)
 			c.checkTypeName(struct_.Name)
 			for _, field := range struct_.Fields {
-				c.checkAnnotationApplications(field.AnnotationApplications)
+				c.checkFieldUID("field", struct_.Name.Name, field.Name, field.Reference.AttributeUID, field.Location)
+				c.checkAnnotationApplications(field.AnnotationApplications, proto.AnnotationScope_AnnotationScopeField)
 				c.checkTypeSpecifier(field.Type, []idl.TypeKind{idl.TypeKindPrimitive, idl.TypeKindData, idl.TypeKindVirtual, idl.TypeKindStruct, idl.TypeKindEnum, idl.TypeKindAPI, idl.TypeKindSDK})
 				if field.DefaultValue != nil {
 					c.checkValue(field.DefaultValue, field.Type)
 				}
+				c.checkFieldType(field)
+			}
+			// Unions become oneofs, which have no field number, so their UIDs
+			// only have to be distinct from those of the struct's fields,
+			// which the symbol table already ensures.
+			for _, union := range struct_.Unions {
+				c.checkAnnotationApplications(union.AnnotationApplications, proto.AnnotationScope_AnnotationScopeUnion)
+			}
+			members := make([]reservable, 0, len(struct_.Fields)+len(struct_.Unions))
+			for _, field := range struct_.Fields {
+				members = append(members, reservable{"field", field.Name, &field.Reference.AttributeUID, field.Location})
 			}
+			// Unions have no protobuf equivalent of a field number, so only
+			// their names can conflict with a reservation.
 			for _, union := range struct_.Unions {
-				c.checkAnnotationApplications(union.AnnotationApplications)
+				members = append(members, reservable{"union", union.Name, nil, union.Location})
 			}
+			c.checkReserved(struct_.Name.Name, struct_.Location, struct_.Reserved, struct_.ReservedNames, members, false)
+			c.checkNestedOptionsExtensions(struct_)
+			c.checkStructExtensions(struct_.Extensions)
 		}
 		for _, enum := range module.Enums {
-			c.checkAnnotationApplications(enum.AnnotationApplications)
+			c.checkAnnotationApplications(enum.AnnotationApplications, proto.AnnotationScope_AnnotationScopeEnum)
+			members := make([]reservable, 0, len(enum.Enumerants))
 			for _, enumerant := range enum.Enumerants {
-				c.checkAnnotationApplications(enumerant.AnnotationApplications)
+				c.checkEnumerantUID(enum.Name, enumerant)
+				c.checkAnnotationApplications(enumerant.AnnotationApplications, proto.AnnotationScope_AnnotationScopeEnumerant)
+				members = append(members, reservable{"enumerant", enumerant.Name, &enumerant.Reference.AttributeUID, enumerant.Location})
 			}
+			c.checkEnumReservedUIDs(enum)
+			c.checkReserved(enum.Name, enum.Location, enum.Reserved, enum.ReservedNames, members, true)
 		}
 		for _, api := range module.APIs {
-			c.checkAnnotationApplications(api.AnnotationApplications)
+			c.checkAnnotationApplications(api.AnnotationApplications, proto.AnnotationScope_AnnotationScopeAPI)
 			c.checkTypeName(api.Name)
 			for _, extends := range api.Extends {
 				c.checkTypeSpecifier(extends, []idl.TypeKind{idl.TypeKindAPI})
 			}
+			c.checkExtends(c.extends.apis[extendableKey(api.Reference)])
+			members := make([]reservable, 0, len(api.Methods))
 			for _, apiMethod := range api.Methods {
-				c.checkAnnotationApplications(apiMethod.AnnotationApplications)
+				members = append(members, reservable{"method", apiMethod.Name, &apiMethod.Reference.AttributeUID, apiMethod.Location})
+			}
+			c.checkReserved(api.Name.Name, api.Location, api.Reserved, api.ReservedNames, members, false)
+			for _, apiMethod := range api.Methods {
+				c.checkAnnotationApplications(apiMethod.AnnotationApplications, proto.AnnotationScope_AnnotationScopeAPIMethod)
 				c.checkTypeSpecifier(apiMethod.Input, []idl.TypeKind{idl.TypeKindStruct})
 				c.checkTypeSpecifierForAPI(apiMethod.Input)
 				c.checkTypeSpecifier(apiMethod.Output, []idl.TypeKind{idl.TypeKindStruct})
@@ -347,13 +489,19 @@ func (c *imageChecker) check() {
 			}
 		}
 		for _, sdk := range module.SDKs {
-			c.checkAnnotationApplications(sdk.AnnotationApplications)
+			c.checkAnnotationApplications(sdk.AnnotationApplications, proto.AnnotationScope_AnnotationScopeSDK)
 			c.checkTypeName(sdk.Name)
 			for _, extends := range sdk.Extends {
 				c.checkTypeSpecifier(extends, []idl.TypeKind{idl.TypeKindSDK})
 			}
+			c.checkExtends(c.extends.sdks[extendableKey(sdk.Reference)])
+			members := make([]reservable, 0, len(sdk.Methods))
+			for _, sdkMethod := range sdk.Methods {
+				members = append(members, reservable{"method", sdkMethod.Name, &sdkMethod.Reference.AttributeUID, sdkMethod.Location})
+			}
+			c.checkReserved(sdk.Name.Name, sdk.Location, sdk.Reserved, sdk.ReservedNames, members, false)
 			for _, sdkMethod := range sdk.Methods {
-				c.checkAnnotationApplications(sdkMethod.AnnotationApplications)
+				c.checkAnnotationApplications(sdkMethod.AnnotationApplications, proto.AnnotationScope_AnnotationScopeSDKMethod)
 				for _, sdkMethodInput := range sdkMethod.Input {
 					c.checkTypeSpecifier(sdkMethodInput.Type, []idl.TypeKind{idl.TypeKindPrimitive, idl.TypeKindData, idl.TypeKindVirtual, idl.TypeKindStruct, idl.TypeKindEnum, idl.TypeKindAPI, idl.TypeKindSDK})
 				}
@@ -363,38 +511,341 @@ func (c *imageChecker) check() {
 			}
 		}
 		for _, annotation := range module.Annotations {
-			c.checkTypeSpecifier(annotation.Type, []idl.TypeKind{idl.TypeKindPrimitive, idl.TypeKindData, idl.TypeKindStruct})
+			c.checkTypeSpecifier(annotation.Type, []idl.TypeKind{idl.TypeKindPrimitive, idl.TypeKindData, idl.TypeKindVirtual, idl.TypeKindStruct, idl.TypeKindEnum})
+			c.checkExtension(annotation)
 		}
 		for _, constant := range module.Constants {
-			c.checkAnnotationApplications(constant.AnnotationApplications)
+			c.checkAnnotationApplications(constant.AnnotationApplications, proto.AnnotationScope_AnnotationScopeConst)
 			c.checkTypeSpecifier(constant.Type, []idl.TypeKind{idl.TypeKindPrimitive})
 			c.checkValue(constant.Value, constant.Type)
 		}
 	}
 }
 
-func (c *imageChecker) checkAnnotationApplications(annotationApplications []*proto.AnnotationApplication) {
-	// TODO 2023.11.26: check that the annotation's scope matches the application
+// checkFieldUID reports a field whose UID can't be used as a protobuf field
+// number.
+func (c *imageChecker) checkFieldUID(kind string, parent string, name string, uid uint64, location *proto.SourceLocation) {
+	if problem := fieldUIDProblem(uid); problem != "" {
+		c.reporter.Report(exc.New(c.location(location), exc.CodeInvalidUID, fmt.Sprintf("%s %s.%s has the UID @%d but %s", kind, parent, name, uid, problem)))
+	}
+}
+
+// checkFieldType reports a field whose $(Protobuf.FieldType()) doesn't apply to
+// its type, or that is a group but can't be one.
+func (c *imageChecker) checkFieldType(field *proto.Field) {
+	if _, err := c.image.FieldType(field); err != nil {
+		c.reporter.Report(exc.New(c.location(field.Location), exc.CodeInvalidFieldType, err.Error()))
+	}
+	if err := c.image.CheckGroup(field); err != nil {
+		c.reporter.Report(exc.New(c.location(field.Location), exc.CodeInvalidFieldType, err.Error()))
+	}
+}
+
+// checkProtobufSyntax reports the declarations of a module that can't be
+// converted to the protobuf syntax or edition that it selects with
+// $(Protobuf.Syntax()) or $(Protobuf.Edition()). Modules that select neither
+// are converted to proto3 as closely as they can be, so they aren't checked.
+func (c *imageChecker) checkProtobufSyntax(module *proto.Module) {
+	// The edition is the more specific of the two, so a problem with the
+	// pair is reported where the edition is selected.
+	selection := idl.GetProtobufAnnotationApplication(module.AnnotationApplications, "Edition")
+	if selection == nil {
+		selection = idl.GetProtobufAnnotationApplication(module.AnnotationApplications, "Syntax")
+	}
+	if selection == nil {
+		return
+	}
+	syntax, _, err := idl.ProtobufSyntax(module)
+	if err != nil {
+		c.reporter.Report(exc.New(c.location(selection.Location), exc.CodeInvalidOption, err.Error()))
+		return
+	}
+	fields := make([]*proto.Field, 0, len(module.Extensions))
+	for _, extension := range module.Extensions {
+		fields = append(fields, extension.Field)
+	}
+	for _, struct_ := range module.Structs {
+		fields = append(fields, struct_.Fields...)
+		for _, extension := range struct_.Extensions {
+			fields = append(fields, extension.Field)
+		}
+	}
+	for _, field := range fields {
+		if problem := c.protobufFieldProblem(syntax, field); problem != "" {
+			c.reporter.Report(exc.New(c.location(field.Location), exc.CodeUnsupportedBySyntax, fmt.Sprintf("field %s %s", field.Name, problem)))
+		}
+	}
+	if syntax == "proto3" {
+		for _, enum := range module.Enums {
+			if closed := idl.GetProtobufAnnotation(enum.AnnotationApplications, "ClosedEnum"); closed != nil && closed.GetBool().GetValue() {
+				c.reporter.Report(exc.New(c.location(enum.Location), exc.CodeUnsupportedBySyntax, fmt.Sprintf("enum %s is closed, which proto3 doesn't support", enum.Name)))
+			}
+		}
+	}
+}
+
+// protobufFieldProblem describes why a field can't be converted to a syntax,
+// or returns "" if it can.
+func (c *imageChecker) protobufFieldProblem(syntax string, field *proto.Field) string {
+	required := idl.GetProtobufAnnotation(field.AnnotationApplications, "Required").GetBool().GetValue()
+	if syntax == "proto3" {
+		switch {
+		case required:
+			return "is required, which proto3 doesn't support"
+		case idl.GetProtobufAnnotation(field.AnnotationApplications, "Group").GetBool().GetValue():
+			return "is a group, which proto3 doesn't support"
+		case field.DefaultValue != nil:
+			return "has a default value, which proto3 doesn't support"
+		case c.image.IsClosedEnum(field.Type):
+			return "has a closed enum type, which proto3 doesn't support"
+		}
+		return ""
+	}
+	if field.DefaultValue == nil {
+		return ""
+	}
+	valueType := field.Type
+	hasPresence := idl.BuiltinTypeName(valueType) == "Presence"
+	if hasPresence {
+		valueType = valueType.GetResolved().GetParameters()[0]
+	}
+	switch idl.BuiltinTypeName(valueType) {
+	case "List", "Map":
+		return fmt.Sprintf("has a default value, which %s doesn't support for repeated fields", syntax)
+	}
+	if kind, _ := c.image.Lookup(valueType.GetResolved().GetReference()); kind == idl.TypeKindStruct {
+		return fmt.Sprintf("has a default value, which %s doesn't support for message fields", syntax)
+	}
+	if syntax == "editions" && !hasPresence && !required {
+		return "has a default value but no presence, which editions require of fields with defaults"
+	}
+	return ""
+}
+
+// checkExtension reports an annotation that is converted to protobuf
+// extensions whose number is already used by another annotation for the same
+// options message.
+func (c *imageChecker) checkExtension(annotation *proto.Annotation) {
+	if !c.image.IsExtension(annotation) {
+		return
+	}
+	number := idl.ExtensionNumber(annotation.Reference)
+	for _, extendee := range idl.Extendees(annotation) {
+		if existing, ok := c.claimExtension(extensionKey{extendee, number}, "annotation "+annotation.Name); ok {
+			c.reporter.Report(exc.New(c.location(annotation.Location), exc.CodeExtensionCollision, fmt.Sprintf("annotation %s has the protobuf extension number %d of %s, which %s already has", annotation.Name, number, extendee, existing)))
+		}
+	}
+}
+
+// checkStructExtensions checks extensions of structs, which are declared
+// either at the top level of a module or within a struct. The UID of each
+// extension must be within an extension range of the struct that it extends
+// and must not be used by another extension of that struct.
+func (c *imageChecker) checkStructExtensions(extensions []*proto.Extension) {
+	for _, extension := range extensions {
+		field := extension.Field
+		c.checkAnnotationApplications(field.AnnotationApplications, proto.AnnotationScope_AnnotationScopeField)
+		c.checkTypeSpecifier(field.Type, []idl.TypeKind{idl.TypeKindPrimitive, idl.TypeKindData, idl.TypeKindVirtual, idl.TypeKindStruct, idl.TypeKindEnum, idl.TypeKindAPI, idl.TypeKindSDK})
+		if field.DefaultValue != nil {
+			c.checkValue(field.DefaultValue, field.Type)
+		}
+		c.checkFieldType(field)
+		c.checkTypeSpecifier(extension.Extendee, []idl.TypeKind{idl.TypeKindStruct})
+		resolved, ok := extension.Extendee.Reference.(*proto.TypeSpecifier_Resolved)
+		if !ok {
+			continue
+		}
+		kind, declaration := c.image.Lookup(resolved.Resolved.Reference)
+		if kind != idl.TypeKindStruct {
+			continue
+		}
+		extendee := declaration.(*proto.Struct)
+		uid := field.Reference.AttributeUID
+		inRange := slices.ContainsFunc(extendee.ExtensionRanges, func(r *proto.ExtensionRange) bool {
+			return uid >= r.Start && uid <= r.End
+		})
+		if !inRange {
+			c.reporter.Report(exc.New(c.location(field.Location), exc.CodeInvalidUID, fmt.Sprintf("extension %s of %s has the UID @%d, which isn't within an extension range of %s", field.Name, extendee.Name.Name, uid, extendee.Name.Name)))
+			continue
+		}
+		if existing, ok := c.claimExtension(extensionKey{extendableKey(resolved.Resolved.Reference), int32(uid)}, "extension "+field.Name); ok {
+			c.reporter.Report(exc.New(c.location(field.Location), exc.CodeExtensionCollision, fmt.Sprintf("extension %s of %s has the UID @%d, which %s already has", field.Name, extendee.Name.Name, uid, existing)))
+		}
+	}
+}
+
+// checkNestedOptionsExtensions reports the extensions of the protobuf options
+// messages that are declared within a struct. Only those at the top level of a
+// file are converted to annotations.
+func (c *imageChecker) checkNestedOptionsExtensions(struct_ *proto.Struct) {
+	for _, extension := range struct_.Extensions {
+		resolved, ok := extension.Extendee.Reference.(*proto.TypeSpecifier_Resolved)
+		if !ok {
+			continue
+		}
+		kind, declaration := c.image.Lookup(resolved.Resolved.Reference)
+		if kind != idl.TypeKindStruct {
+			continue
+		}
+		extendee := declaration.(*proto.Struct)
+		for _, module := range c.image.Modules {
+			if module.UID != extendee.Reference.ModuleUID {
+				continue
+			}
+			if name := module.ProtobufPackage + "." + extendee.Name.Name; idl.IsOptionsMessage(name) {
+				c.reporter.Report(exc.New(c.location(extension.Field.Location), exc.CodeUnimplemented, fmt.Sprintf("extension %s of %s is declared within %s, which isn't supported; extensions of the protobuf options messages must be declared at the top level of a file", extension.Field.Name, name, struct_.Name.Name)))
+			}
+		}
+	}
+}
+
+// checkEnumerantUID reports an enumerant whose UID can't be used as a protobuf
+// enum value. Negative protobuf enum values are represented by UIDs that are
+// sign extended from 32 bits, so those are allowed too.
+func (c *imageChecker) checkEnumerantUID(parent string, enumerant *proto.Enumerant) {
+	uid := enumerant.Reference.AttributeUID
+	if !isEnumValueUID(uid) {
+		c.reporter.Report(exc.New(c.location(enumerant.Location), exc.CodeInvalidUID, fmt.Sprintf("enumerant %s.%s has the UID @%d but enum values must be within the range of an int32", parent, enumerant.Name, uid)))
+	}
+}
+
+// checkEnumReservedUIDs reports the reserved ranges of an enum that can't be
+// converted to reserved ranges of protobuf enum values.
+func (c *imageChecker) checkEnumReservedUIDs(enum *proto.Enum) {
+	for _, r := range enum.Reserved {
+		if !isEnumValueUID(r.Start) || !isEnumValueUID(r.End) {
+			c.reporter.Report(exc.New(c.location(enum.Location), exc.CodeInvalidUID, fmt.Sprintf("the reserved range @%d to @%d of %s isn't within the range of an int32, which enum values must be", r.Start, r.End, enum.Name)))
+		}
+	}
+}
+
+// isEnumValueUID reports whether a UID can be used as a protobuf enum value.
+func isEnumValueUID(uid uint64) bool {
+	return int64(uid) >= math.MinInt32 && int64(uid) <= math.MaxInt32
+}
+
+// reservable is a member of a declaration that may conflict with the
+// declaration's reserved UIDs and names. The uid is nil for members that have
+// no UID that can be reserved.
+type reservable struct {
+	kind     string
+	name     string
+	uid      *uint64
+	location *proto.SourceLocation
+}
+
+// checkReserved reports every member of a declaration whose UID falls in one of
+// the declaration's reserved ranges, or whose name is reserved. The UIDs of
+// enumerants are signed, since negative protobuf enum values are sign
+// extended, so their ranges are compared as signed integers.
+func (c *imageChecker) checkReserved(parent string, location *proto.SourceLocation, reserved []*proto.ReservedRange, reservedNames []string, members []reservable, signed bool) {
+	less := func(a uint64, b uint64) bool {
+		if signed {
+			return int64(a) < int64(b)
+		}
+		return a < b
+	}
+	for _, r := range reserved {
+		if less(r.End, r.Start) {
+			c.reporter.Report(exc.New(c.location(location), exc.CodeReservedUID, fmt.Sprintf("the reserved range @%d to @%d of %s is empty", r.Start, r.End, parent)))
+		}
+	}
+	names := make(map[string]bool, len(reservedNames))
+	for _, name := range reservedNames {
+		names[name] = true
+	}
+	for _, member := range members {
+		if names[member.name] {
+			c.reporter.Report(exc.New(c.location(member.location), exc.CodeReservedName, fmt.Sprintf("the name of %s %s.%s is reserved", member.kind, parent, member.name)))
+		}
+		if member.uid == nil {
+			continue
+		}
+		for _, r := range reserved {
+			if less(*member.uid, r.Start) || less(r.End, *member.uid) {
+				continue
+			}
+			if r.Start == r.End {
+				c.reporter.Report(exc.New(c.location(member.location), exc.CodeReservedUID, fmt.Sprintf("%s %s.%s has the UID @%d, which is reserved", member.kind, parent, member.name, *member.uid)))
+			} else {
+				c.reporter.Report(exc.New(c.location(member.location), exc.CodeReservedUID, fmt.Sprintf("%s %s.%s has the UID @%d, which falls in the reserved range @%d to @%d", member.kind, parent, member.name, *member.uid, r.Start, r.End)))
+			}
+			break
+		}
+	}
+}
+
+// checkAnnotationApplications checks the annotations applied to a declaration,
+// which is of the given scope.
+func (c *imageChecker) checkAnnotationApplications(annotationApplications []*proto.AnnotationApplication, scope proto.AnnotationScope) {
 	for _, annotationApplication := range annotationApplications {
 		resolved, ok := annotationApplication.Annotation.Reference.(*proto.TypeSpecifier_Resolved)
 		if !ok {
-			c.reporter.Report(exc.New(exc.Location{
-				// TODO 2023.11.26: location?
-			}, exc.CodeUnresolvedReference, fmt.Sprintf("Unexpected unresolved reference while type checking")))
+			c.reporter.Report(exc.New(c.location(annotationApplication.Location), exc.CodeUnresolvedReference, fmt.Sprintf("Unexpected unresolved reference while type checking")))
 		} else {
-			kind, declaration := c.lookup(resolved.Resolved.Reference)
+			kind, declaration := c.lookup(resolved.Resolved.Reference, annotationApplication.Location)
 			if kind != idl.TypeKindAnnotation {
-				c.reporter.Report(exc.New(exc.Location{
-					// TODO 2023.11.26: location?
-				}, exc.CodeWrongTypeKind, fmt.Sprintf("unexpected %d (expecting annotation)", kind)))
+				c.reporter.Report(exc.New(c.location(annotationApplication.Location), exc.CodeWrongTypeKind, fmt.Sprintf("unexpected %d (expecting annotation)", kind)))
 			} else {
 				annotation := declaration.(*proto.Annotation)
+				c.checkAnnotationScope(annotation, annotationApplication, scope)
 				c.checkValue(annotationApplication.Value, annotation.Type)
+				if err := idl.CheckOption(annotationApplication); err != nil {
+					c.reporter.Report(exc.New(c.location(annotationApplication.Location), exc.CodeInvalidOption, err.Error()))
+				}
 			}
 		}
 	}
 }
 
+// checkAnnotationScope reports an annotation that is applied to a declaration
+// outside of the annotation's scopes. An annotation with the * scope may be
+// applied to anything.
+func (c *imageChecker) checkAnnotationScope(annotation *proto.Annotation, annotationApplication *proto.AnnotationApplication, scope proto.AnnotationScope) {
+	allowed := make([]string, 0, len(annotation.Scopes))
+	for _, annotationScope := range annotation.Scopes {
+		if annotationScope == scope || annotationScope == proto.AnnotationScope_AnnotationScopeStar {
+			return
+		}
+		allowed = append(allowed, annotationScopeName(annotationScope))
+	}
+	c.reporter.Report(exc.New(c.location(annotationApplication.Location), exc.CodeAnnotationScope, fmt.Sprintf("annotation %s can't be applied to %s (allowed scopes: %s)", annotation.Name, annotationScopeName(scope), strings.Join(allowed, ", "))))
+}
+
+// annotationScopeName returns the name of an annotation scope as it is written
+// in an annotation declaration.
+func annotationScopeName(scope proto.AnnotationScope) string {
+	switch scope {
+	case proto.AnnotationScope_AnnotationScopeModule:
+		return "module"
+	case proto.AnnotationScope_AnnotationScopeUnion:
+		return "union"
+	case proto.AnnotationScope_AnnotationScopeStruct:
+		return "struct"
+	case proto.AnnotationScope_AnnotationScopeField:
+		return "field"
+	case proto.AnnotationScope_AnnotationScopeEnumerant:
+		return "enumerant"
+	case proto.AnnotationScope_AnnotationScopeEnum:
+		return "enum"
+	case proto.AnnotationScope_AnnotationScopeAPI:
+		return "api"
+	case proto.AnnotationScope_AnnotationScopeAPIMethod:
+		return "apimethod"
+	case proto.AnnotationScope_AnnotationScopeSDK:
+		return "sdk"
+	case proto.AnnotationScope_AnnotationScopeSDKMethod:
+		return "sdkmethod"
+	case proto.AnnotationScope_AnnotationScopeConst:
+		return "const"
+	case proto.AnnotationScope_AnnotationScopeImport:
+		return "import"
+	case proto.AnnotationScope_AnnotationScopeStar:
+		return "*"
+	}
+	return scope.String()
+}
+
 func (c *imageChecker) checkTypeName(typeName *proto.TypeName) {
 	for _, parameter := range typeName.Parameters {
 		c.checkTypeSpecifier(parameter, []idl.TypeKind{idl.TypeKindPrimitive, idl.TypeKindData, idl.TypeKindVirtual, idl.TypeKindStruct, idl.TypeKindEnum})
@@ -209,6 +209,50 @@ func TestChecker(t *testing.T) {
 			},
 			expectCheckError: true,
 		},
+		{
+			name: "annotation applied outside of its scope",
+			files: []CheckerTestFile{
+				{
+					kind:     idl.FileKindMicroglot,
+					uri:      "/test.mglot",
+					contents: "syntax = \"mglot0\"\nmodule = @13\nstruct Foo {} $(Protobuf.JsonName(\"x\"))\n",
+				},
+			},
+			expectCheckError: true,
+		},
+		{
+			name: "module annotation applied to an enumerant",
+			files: []CheckerTestFile{
+				{
+					kind:     idl.FileKindMicroglot,
+					uri:      "/test.mglot",
+					contents: "syntax = \"mglot0\"\nmodule = @13\nannotation Foo(module) :Text\nenum Bar { Baz $(Foo(\"x\")) }\n",
+				},
+			},
+			expectCheckError: true,
+		},
+		{
+			name: "annotation applied within one of its scopes",
+			files: []CheckerTestFile{
+				{
+					kind:     idl.FileKindMicroglot,
+					uri:      "/test.mglot",
+					contents: "syntax = \"mglot0\"\nmodule = @13\nannotation Foo(enum, enumerant) :Text\nenum Bar { Baz $(Foo(\"x\")) } $(Foo(\"y\"))\n",
+				},
+			},
+			expectCheckError: false,
+		},
+		{
+			name: "star annotation applied anywhere",
+			files: []CheckerTestFile{
+				{
+					kind:     idl.FileKindMicroglot,
+					uri:      "/test.mglot",
+					contents: "syntax = \"mglot0\"\nmodule = @13 $(Foo(\"m\"))\nannotation Foo(*) :Text\nstruct In {} $(Foo(\"s\"))\napi Bar { Method(:In) returns (:In) $(Foo(\"a\")) }\nconst Baz :Int32 = 1 $(Foo(\"c\"))\n",
+				},
+			},
+			expectCheckError: false,
+		},
 		{
 			name: "structs as api method input/output",
 			files: []CheckerTestFile{
@@ -242,6 +286,160 @@ func TestChecker(t *testing.T) {
 			},
 			expectCheckError: true,
 		},
+		{
+			name: "map literals in annotation applications",
+			files: []CheckerTestFile{
+				{
+					kind:     idl.FileKindMicroglot,
+					uri:      "/test.mglot",
+					contents: "syntax = \"mglot0\"\nmodule = @13\nannotation Foo(struct) :Map<:Text, :Int32>\nstruct Bar {} $(Foo([\"a\": 1, \"b\": 2]))\n",
+				},
+			},
+			expectCheckError: false,
+		},
+		{
+			name: "map literals in annotation applications (empty)",
+			files: []CheckerTestFile{
+				{
+					kind:     idl.FileKindMicroglot,
+					uri:      "/test.mglot",
+					contents: "syntax = \"mglot0\"\nmodule = @13\nannotation Foo(struct) :Map<:Text, :Int32>\nstruct Bar {} $(Foo([:]))\n",
+				},
+			},
+			expectCheckError: false,
+		},
+		{
+			name: "map literals in annotation applications (non-map literal)",
+			files: []CheckerTestFile{
+				{
+					kind:     idl.FileKindMicroglot,
+					uri:      "/test.mglot",
+					contents: "syntax = \"mglot0\"\nmodule = @13\nannotation Foo(struct) :Map<:Text, :Int32>\nstruct Bar {} $(Foo([1, 2]))\n",
+				},
+			},
+			expectCheckError: true,
+		},
+		{
+			name: "map literals in annotation applications (key has wrong type)",
+			files: []CheckerTestFile{
+				{
+					kind:     idl.FileKindMicroglot,
+					uri:      "/test.mglot",
+					contents: "syntax = \"mglot0\"\nmodule = @13\nannotation Foo(struct) :Map<:Text, :Int32>\nstruct Bar {} $(Foo([1: 2]))\n",
+				},
+			},
+			expectCheckError: true,
+		},
+		{
+			name: "map literals in annotation applications (value has wrong type)",
+			files: []CheckerTestFile{
+				{
+					kind:     idl.FileKindMicroglot,
+					uri:      "/test.mglot",
+					contents: "syntax = \"mglot0\"\nmodule = @13\nannotation Foo(struct) :Map<:Text, :Int32>\nstruct Bar {} $(Foo([\"a\": \"b\"]))\n",
+				},
+			},
+			expectCheckError: true,
+		},
+		{
+			name: "map literals as default values",
+			files: []CheckerTestFile{
+				{
+					kind:     idl.FileKindMicroglot,
+					uri:      "/test.mglot",
+					contents: "syntax = \"mglot0\"\nmodule = @13\nstruct Foo { bar :Map<:Int32, :Text> = [1: \"a\"] }\n",
+				},
+			},
+			expectCheckError: false,
+		},
+		{
+			name: "list of lists",
+			files: []CheckerTestFile{
+				{
+					kind:     idl.FileKindMicroglot,
+					uri:      "/test.mglot",
+					contents: "syntax = \"mglot0\"\nmodule = @13\nstruct Foo { bar :List<:List<:Text>> }\n",
+				},
+			},
+			expectCheckError: true,
+		},
+		{
+			name: "map keys of data",
+			files: []CheckerTestFile{
+				{
+					kind:     idl.FileKindMicroglot,
+					uri:      "/test.mglot",
+					contents: "syntax = \"mglot0\"\nmodule = @13\nstruct Foo { bar :Map<:Data, :Text> }\n",
+				},
+			},
+			expectCheckError: true,
+		},
+		{
+			name: "map keys of floats",
+			files: []CheckerTestFile{
+				{
+					kind:     idl.FileKindMicroglot,
+					uri:      "/test.mglot",
+					contents: "syntax = \"mglot0\"\nmodule = @13\nstruct Foo { bar :Map<:Float64, :Text> }\n",
+				},
+			},
+			expectCheckError: true,
+		},
+		{
+			name: "map keys of structs",
+			files: []CheckerTestFile{
+				{
+					kind:     idl.FileKindMicroglot,
+					uri:      "/test.mglot",
+					contents: "syntax = \"mglot0\"\nmodule = @13\nstruct Foo { bar :Map<:Foo, :Text> }\n",
+				},
+			},
+			expectCheckError: true,
+		},
+		{
+			name: "map values of maps",
+			files: []CheckerTestFile{
+				{
+					kind:     idl.FileKindMicroglot,
+					uri:      "/test.mglot",
+					contents: "syntax = \"mglot0\"\nmodule = @13\nstruct Foo { bar :Map<:Text, :Map<:Text, :Text>> }\n",
+				},
+			},
+			expectCheckError: true,
+		},
+		{
+			name: "map values of structs",
+			files: []CheckerTestFile{
+				{
+					kind:     idl.FileKindMicroglot,
+					uri:      "/test.mglot",
+					contents: "syntax = \"mglot0\"\nmodule = @13\nstruct Foo { bar :Map<:Bool, :Foo> }\n",
+				},
+			},
+			expectCheckError: false,
+		},
+		{
+			name: "presence of a list",
+			files: []CheckerTestFile{
+				{
+					kind:     idl.FileKindMicroglot,
+					uri:      "/test.mglot",
+					contents: "syntax = \"mglot0\"\nmodule = @13\nstruct Foo { bar :Presence<:List<:Text>> }\n",
+				},
+			},
+			expectCheckError: true,
+		},
+		{
+			name: "presence of a struct",
+			files: []CheckerTestFile{
+				{
+					kind:     idl.FileKindMicroglot,
+					uri:      "/test.mglot",
+					contents: "syntax = \"mglot0\"\nmodule = @13\nstruct Foo { bar :Presence<:Foo> }\n",
+				},
+			},
+			expectCheckError: false,
+		},
 	}
 
 	subcompilers := DefaultSubCompilers()
@@ -9,13 +9,15 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"net/url"
 	"os"
 	"path/filepath"
 	"runtime"
+	"slices"
+	"sort"
 	"strings"
-	"sync"
 
 	"gopkg.microglot.org/mglotc/internal/exc"
 	"gopkg.microglot.org/mglotc/internal/fs"
@@ -47,6 +49,39 @@ func OptionWithExcReporter(reporter exc.Reporter) Option {
 	}
 }
 
+// OptionWithUIDLock makes the compiler reuse the UIDs recorded in the given
+// lock, and record any UIDs that it generates. Setting update accepts changes
+// to recorded UIDs rather than reporting them.
+func OptionWithUIDLock(lock *UIDLock, update bool) Option {
+	return func(c *compiler) error {
+		c.UIDLock = lock
+		c.UpdateUIDLock = update
+		return nil
+	}
+}
+
+// OptionWithProtobufSyntax makes the compiler convert microglot modules to
+// protobuf with the given syntax, which is proto2 or proto3, or with the given
+// edition, in place of any $(Protobuf.Syntax()) or $(Protobuf.Edition()) that
+// they have. At most one of the two can be set.
+func OptionWithProtobufSyntax(syntax string, edition string) Option {
+	return func(c *compiler) error {
+		switch {
+		case syntax != "" && edition != "":
+			return errors.New("only one of a protobuf syntax and a protobuf edition can be chosen")
+		case syntax != "" && syntax != "proto2" && syntax != "proto3":
+			return fmt.Errorf("unknown protobuf syntax %q; should be proto2 or proto3", syntax)
+		case edition != "":
+			if _, err := idl.Edition(edition); err != nil {
+				return err
+			}
+		}
+		c.ProtobufSyntax = syntax
+		c.ProtobufEdition = edition
+		return nil
+	}
+}
+
 func New(opts ...Option) (idl.Compiler, error) {
 	c := &compiler{}
 	for _, opt := range opts {
@@ -64,6 +99,9 @@ func New(opts ...Option) (idl.Compiler, error) {
 		}
 		c.FS = dfs
 	}
+	// The well-known protobuf files can be imported without being present in
+	// any of the file systems, as they can with protoc.
+	c.FS = fs.FileSystemMulti{c.FS, fs.NewFileSystemWellKnown()}
 	if c.MaxConcurrency == 0 {
 		max := runtime.GOMAXPROCS(-1)
 		cpus := runtime.NumCPU()
@@ -91,6 +129,12 @@ type compiler struct {
 	Semaphore      *semaphore
 	Reporter       exc.Reporter
 	SubCompilers   map[idl.FileKind]SubCompiler
+	UIDLock        *UIDLock
+	UpdateUIDLock  bool
+	// ProtobufSyntax and ProtobufEdition override the protobuf syntax of
+	// microglot modules when either is set.
+	ProtobufSyntax  string
+	ProtobufEdition string
 }
 
 func (self *compiler) Compile(ctx context.Context, req *idl.CompileRequest) (*idl.CompileResponse, error) {
@@ -102,7 +146,7 @@ func (self *compiler) Compile(ctx context.Context, req *idl.CompileRequest) (*id
 	for _, target := range targets {
 		in, err := self.FS.Open(ctx, target)
 		if err != nil {
-			panic(err.Error())
+			return nil, err
 		}
 		for _, inf := range in {
 			if inf.Kind(ctx) == idl.FileKindNone {
@@ -111,140 +155,135 @@ func (self *compiler) Compile(ctx context.Context, req *idl.CompileRequest) (*id
 			files = append(files, inf)
 		}
 	}
-	modules := make([]*proto.Module, 0, len(files))
-	loaded := &sync.Map{}
-	results := make(chan fileResult)
-	expectedResults := len(files) + 1
-	symbols := globalSymbolTable{}
 
-	go func() {
-		image, err := self.compileFile(ctx, fs.NewFileString("/protobuf.mglot", idl.PROTOBUF_IDL, idl.FileKindMicroglot), loaded, &symbols, req.DumpTokens, req.DumpTree)
-		results <- fileResult{image, err}
-	}()
+	s := newScheduler(ctx, self, req.DumpTokens, req.DumpTree)
+	defer s.stop()
 
+	// Files that contain multiple modules, such as descriptor sets and images,
+	// are compiled ahead of all other files so that every module they contain
+	// is registered before any import is resolved. Otherwise, an import of a
+	// module that is only available from such a file could race with the file
+	// itself and fail to open.
+	sourceFiles := make([]idl.File, 0, len(files))
 	for _, file := range files {
-		go func(file idl.File) {
-			image, err := self.compileFile(ctx, file, loaded, &symbols, req.DumpTokens, req.DumpTree)
-			results <- fileResult{image, err}
-		}(file)
-	}
-
-	// TODO 2024.01.08: the racing here means that reported errors can vary from run to run,
-	// which can be surprising/off-putting. Waiting for all of the raced goroutines before
-	// returning errors might be better?
-	for x := 0; x < expectedResults; x = x + 1 {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case result := <-results:
-			if result.err != nil {
-				caught := self.Reporter.Reported()
-				if len(caught) > 0 {
-					return nil, MultiException(caught)
-				} else {
-					return nil, result.err
-				}
-			}
-			if result.module != nil {
-				modules = append(modules, result.module)
-				for _, import_ := range result.module.Imports {
-					uri := target.Normalize(import_.ImportedURI)
-					in, err := self.FS.Open(ctx, uri)
-					if err != nil {
-						return nil, err
-					}
-					for _, inf := range in {
-						if inf.Kind(ctx) == idl.FileKindNone {
-							continue
-						}
-
-						go func(file idl.File) {
-							image, err := self.compileFile(ctx, file, loaded, &symbols, req.DumpTokens, req.DumpTree)
-							results <- fileResult{image, err}
-						}(inf)
-						expectedResults += 1
-					}
-				}
-			}
+		if _, ok := self.SubCompilers[file.Kind(ctx)].(SubCompilerMulti); !ok {
+			sourceFiles = append(sourceFiles, file)
+			continue
 		}
+		s.compile(file)
+	}
+	if err := s.wait(); err != nil {
+		return nil, err
+	}
+	if s.failed != nil {
+		return nil, self.failure(s.failed)
 	}
 
-	linked_modules := make([]*proto.Module, 0, len(modules))
-	linkResults := make(chan fileResult)
-	expectedLinkResults := len(modules)
-
-	for _, mod := range modules {
-		go func(mod proto.Module) {
-			linked_module, err := link(mod, &symbols, self.Reporter)
-			linkResults <- fileResult{linked_module, err}
-		}(*mod)
-	}
-
-	// TODO 2024.01.08: the racing here means that reported errors can vary from run to run,
-	// which can be surprising/off-putting. Waiting for all of the raced goroutines before
-	// returning errors might be better?
-	for x := 0; x < expectedLinkResults; x = x + 1 {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case result := <-linkResults:
-			if result.err != nil {
-				caught := self.Reporter.Reported()
-				if len(caught) > 0 {
-					return nil, MultiException(caught)
-				} else {
-					return nil, result.err
-				}
+	s.compile(fs.NewFileString(protobufURI, idl.PROTOBUF_IDL, idl.FileKindMicroglot))
+	s.compile(sourceFiles...)
+	if err := s.wait(); err != nil {
+		return nil, err
+	}
+	if err := checkImportCycles(s.collected, self.Reporter); err != nil && s.failed == nil {
+		s.failed = err
+	}
+	if s.failed != nil {
+		return nil, self.failure(s.failed)
+	}
+	if len(s.linked) != len(s.collected) {
+		var unlinked []string
+		for uri := range s.collected {
+			if _, ok := s.linked[uri]; !ok {
+				unlinked = append(unlinked, uri)
 			}
-			linked_modules = append(linked_modules, result.module)
 		}
+		slices.Sort(unlinked)
+		return nil, self.failure(fmt.Errorf("modules could not be linked: %s", strings.Join(unlinked, ", ")))
 	}
 
+	// Modules are emitted in URI order so that the resulting Image doesn't
+	// depend on the order in which files finished compiling.
 	final := &idl.Image{}
-	included := make(map[string]bool)
-	for _, mod := range linked_modules {
-		if _, ok := included[mod.URI]; ok {
-			continue
-		}
-		included[mod.URI] = true
+	for _, mod := range s.linked {
 		final.Modules = append(final.Modules, mod)
 	}
+	sort.Slice(final.Modules, func(i, j int) bool { return final.Modules[i].URI < final.Modules[j].URI })
 
+	if self.ProtobufSyntax != "" || self.ProtobufEdition != "" {
+		for _, module := range final.Modules {
+			if module.UID != idl.ProtobufModuleUID && fs.KindOf(module.URI) == idl.FileKindMicroglot {
+				idl.SetProtobufSyntax(module, self.ProtobufSyntax, self.ProtobufEdition)
+			}
+		}
+	}
+	interpretOptions(final, self.Reporter)
+	resolvePacked(final)
 	optimize(final)
 	check(final, self.Reporter)
+	// Problems that the checker reports are usually reported by protoc too,
+	// so protobuf sources are only validated once nothing else is wrong.
+	if len(self.Reporter.Reported()) < 1 {
+		self.validateProtobuf(ctx, final)
+	}
 
 	caught := self.Reporter.Reported()
 	if len(caught) > 0 {
 		return &idl.CompileResponse{
 			Image: final,
-		}, MultiException(caught)
+		}, sortExceptions(caught)
 	}
 	return &idl.CompileResponse{
 		Image: final,
 	}, nil
 }
 
-func (self *compiler) compileFile(ctx context.Context, file idl.File, loaded *sync.Map, symbols *globalSymbolTable, dumpTokens bool, dumpTree bool) (*proto.Module, error) {
-	self.Semaphore.Lock()
-	defer self.Semaphore.Unlock()
-	if _, ok := loaded.Load(file.Path(ctx)); ok {
-		return nil, nil
+// failure returns every exception that has been reported so far, in a stable
+// order, or the given error if nothing has been reported.
+func (self *compiler) failure(err error) error {
+	caught := self.Reporter.Reported()
+	if len(caught) > 0 {
+		return sortExceptions(caught)
 	}
-	loaded.Store(file.Path(ctx), true)
+	return err
+}
+
+func (self *compiler) compileFile(ctx context.Context, file idl.File, dumpTokens bool, dumpTree bool) ([]*proto.Module, error) {
 	sc := self.SubCompilers[file.Kind(ctx)]
 	if sc == nil {
 		e := exc.New(exc.Location{URI: file.Path(ctx)}, exc.CodeUnsupportedFileFormat, "Unsupported file format")
 		return nil, self.Reporter.Report(e)
 	}
-	module, err := sc.CompileFile(ctx, self.Reporter, file, dumpTokens, dumpTree)
-	if err != nil {
-		return nil, err
+	var parsed []*proto.Module
+	if scm, ok := sc.(SubCompilerMulti); ok {
+		modules, err := scm.CompileFileModules(ctx, self.Reporter, file, dumpTokens, dumpTree)
+		if err != nil {
+			return nil, err
+		}
+		parsed = modules
+	} else {
+		module, err := sc.CompileFile(ctx, self.Reporter, file, dumpTokens, dumpTree)
+		if err != nil {
+			return nil, err
+		}
+		parsed = []*proto.Module{module}
 	}
-	completed := completeUIDs(*module)
 
-	err = symbols.collect(*completed, self.Reporter)
-	if err != nil {
-		return nil, err
+	completed := make([]*proto.Module, 0, len(parsed))
+	for _, module := range parsed {
+		// Only microglot sources can give a declaration an explicit UID, so
+		// the UIDs of protobuf sources and images don't belong in a user's
+		// lock, and neither do those of the built-in protobuf module, which
+		// is defined by the compiler.
+		if self.UIDLock == nil || file.Kind(ctx) != idl.FileKindMicroglot || module.URI == protobufURI {
+			completed = append(completed, completeUIDs(*module))
+			continue
+		}
+		generated, err := self.UIDLock.apply(module, self.UpdateUIDLock, self.Reporter)
+		if err != nil {
+			return nil, err
+		}
+		completed = append(completed, completeUIDs(*module))
+		self.UIDLock.record(module.URI, generated)
 	}
 
 	return completed, nil
@@ -269,13 +308,33 @@ func (self *compiler) targetURI(ctx context.Context, target string) string {
 	return target
 }
 
-type fileResult struct {
-	module *proto.Module
-	err    error
-}
-
 type MultiException []exc.Exception
 
+// sortExceptions returns a copy of the given exceptions ordered by location,
+// then by code and message, which makes the output of a failed compilation
+// reproducible.
+func sortExceptions(exceptions []exc.Exception) MultiException {
+	sorted := make(MultiException, len(exceptions))
+	copy(sorted, exceptions)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		left, right := sorted[i].Location(), sorted[j].Location()
+		if left.URI != right.URI {
+			return left.URI < right.URI
+		}
+		if left.Line != right.Line {
+			return left.Line < right.Line
+		}
+		if left.Column != right.Column {
+			return left.Column < right.Column
+		}
+		if sorted[i].Code() != sorted[j].Code() {
+			return sorted[i].Code() < sorted[j].Code()
+		}
+		return sorted[i].Message() < sorted[j].Message()
+	})
+	return sorted
+}
+
 func (self MultiException) Error() string {
 	var b strings.Builder
 	for _, err := range self[:len(self)-1] {
@@ -307,19 +366,37 @@ func newAttributeUID(parent uint64, name string) uint64 {
 	// bytes are used to encode the field type. For compatibility we must remove
 	// the leading three bits.
 	//
-	// TODO 2024.02.01: Attribute UID values must also not be in the range of
-	//                  19000 - 19999. Add a check that validates all field UID
-	//                  values are in the supported range.
-	uid := newUID(parent, name)
-	return uid & 0x1FFFFFFF
+	// Zero and the range reserved for the protobuf implementation aren't valid
+	// field numbers either. A hash that lands on one of those is re-derived
+	// with a counter appended to the name until it doesn't, which keeps the
+	// result stable from one compilation to the next.
+	uid := newUID(parent, name) & idl.MaxFieldUID
+	for attempt := 1; fieldUIDProblem(uid) != ""; attempt = attempt + 1 {
+		uid = newUID(parent, fmt.Sprintf("%s#%d", name, attempt)) & idl.MaxFieldUID
+	}
+	return uid
+}
+
+// fieldUIDProblem describes why the given UID can't be used for a field, or
+// returns an empty string if it can. The descriptions match protoc's.
+func fieldUIDProblem(uid uint64) string {
+	switch {
+	case uid == 0:
+		return "field numbers must be positive integers"
+	case uid > idl.MaxFieldUID:
+		return fmt.Sprintf("field numbers cannot be greater than %d", idl.MaxFieldUID)
+	case uid >= idl.FirstImplementationFieldUID && uid <= idl.LastImplementationFieldUID:
+		return fmt.Sprintf("field numbers %d through %d are reserved for the protocol buffer library implementation", idl.FirstImplementationFieldUID, idl.LastImplementationFieldUID)
+	}
+	return ""
 }
 
 func completeTypeReference(moduleUID uint64, name string, typeReference *proto.TypeReference) {
 	if typeReference.ModuleUID == idl.Incomplete {
 		typeReference.ModuleUID = moduleUID
-	} else {
+	} else if typeReference.ModuleUID != moduleUID {
 		// TODO 2023.09.23: this may become a non-panic in future, but right now means a logic error
-		panic(fmt.Errorf("the module UID for %s is already set, which shouldn't happen", name))
+		panic(fmt.Errorf("the module UID for %s is already set to a different value, which shouldn't happen", name))
 	}
 	if typeReference.TypeUID == idl.Incomplete {
 		typeReference.TypeUID = newUID(moduleUID, name)
@@ -329,16 +406,16 @@ func completeTypeReference(moduleUID uint64, name string, typeReference *proto.T
 func completeAttributeReference(moduleUID uint64, typeUID uint64, name string, attributeReference *proto.AttributeReference) {
 	if attributeReference.ModuleUID == idl.Incomplete {
 		attributeReference.ModuleUID = moduleUID
-	} else {
+	} else if attributeReference.ModuleUID != moduleUID {
 		// TODO 2023.09.23: this may become a non-panic in future, but right now means a logic error
-		panic(fmt.Errorf("the module UID for %s is already set, which shouldn't happen", name))
+		panic(fmt.Errorf("the module UID for %s is already set to a different value, which shouldn't happen", name))
 	}
 
 	if attributeReference.TypeUID == idl.Incomplete {
 		attributeReference.TypeUID = typeUID
-	} else {
+	} else if attributeReference.TypeUID != typeUID {
 		// TODO 2023.09.23: this may become a non-panic in future, but right now means a logic error
-		panic(fmt.Errorf("the type UID for %s is already set, which shouldn't happen", name))
+		panic(fmt.Errorf("the type UID for %s is already set to a different value, which shouldn't happen", name))
 	}
 
 	if attributeReference.AttributeUID == idl.Incomplete {
@@ -350,23 +427,23 @@ func completeAttributeReference(moduleUID uint64, typeUID uint64, name string, a
 func completeSDKInputReference(moduleUID uint64, typeUID uint64, attributeUID uint64, name string, sdkInputReference *proto.SDKInputReference) {
 	if sdkInputReference.ModuleUID == idl.Incomplete {
 		sdkInputReference.ModuleUID = moduleUID
-	} else {
+	} else if sdkInputReference.ModuleUID != moduleUID {
 		// TODO 2023.09.23: this may become a non-panic in future, but right now means a logic error
-		panic(fmt.Errorf("the module UID for %s is already set, which shouldn't happen", name))
+		panic(fmt.Errorf("the module UID for %s is already set to a different value, which shouldn't happen", name))
 	}
 
 	if sdkInputReference.TypeUID == idl.Incomplete {
 		sdkInputReference.TypeUID = typeUID
-	} else {
+	} else if sdkInputReference.TypeUID != typeUID {
 		// TODO 2023.09.23: this may become a non-panic in future, but right now means a logic error
-		panic(fmt.Errorf("the type UID for %s is already set, which shouldn't happen", name))
+		panic(fmt.Errorf("the type UID for %s is already set to a different value, which shouldn't happen", name))
 	}
 
 	if sdkInputReference.AttributeUID == idl.Incomplete {
 		sdkInputReference.AttributeUID = attributeUID
-	} else {
+	} else if sdkInputReference.AttributeUID != attributeUID {
 		// TODO 2023.09.23: this may become a non-panic in future, but right now means a logic error
-		panic(fmt.Errorf("the attribute UID for %s is already set, which shouldn't happen", name))
+		panic(fmt.Errorf("the attribute UID for %s is already set to a different value, which shouldn't happen", name))
 	}
 
 	if sdkInputReference.InputUID == idl.Incomplete {
@@ -375,6 +452,7 @@ func completeSDKInputReference(moduleUID uint64, typeUID uint64, attributeUID ui
 }
 
 // this completes the pre-linked descriptor by generating any missing TypeUID values in the descriptor!
+// Completing a descriptor that is already complete, such as one loaded from an image, is a no-op.
 func completeUIDs(parsed proto.Module) *proto.Module {
 	for _, struct_ := range parsed.Structs {
 		completeTypeReference(parsed.UID, struct_.Name.Name, struct_.Reference)
@@ -0,0 +1,2099 @@
+// © 2023 Microglot LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/bufbuild/protocompile"
+	"github.com/stretchr/testify/require"
+	pb "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"gopkg.microglot.org/mglotc/internal/exc"
+	"gopkg.microglot.org/mglotc/internal/fs"
+	"gopkg.microglot.org/mglotc/internal/idl"
+	"gopkg.microglot.org/mglotc/internal/proto"
+)
+
+type CompilerTestFile struct {
+	kind     idl.FileKind
+	uri      string
+	contents string
+}
+
+// testFS is an in-memory idl.FileSystem for exercising the full compiler.
+type testFS map[string]CompilerTestFile
+
+func newTestFS(files ...CompilerTestFile) testFS {
+	result := make(testFS, len(files))
+	for _, f := range files {
+		result[f.uri] = f
+	}
+	return result
+}
+
+func (self testFS) Open(ctx context.Context, uri string) ([]idl.File, error) {
+	f, ok := self[uri]
+	if !ok {
+		return nil, exc.New(exc.Location{URI: uri}, exc.CodeFileNotFound, fmt.Sprintf("%s not found", uri))
+	}
+	return []idl.File{fs.NewFileString(f.uri, f.contents, f.kind)}, nil
+}
+
+func (self testFS) Write(ctx context.Context, uri string, content string) error {
+	return exc.New(exc.Location{URI: uri}, exc.CodeUnsuportedFileSystemOperation, "write is not supported")
+}
+
+// compileErrors compiles every one of the given files, which must fail, and
+// returns the exceptions that are reported in the order the compiler sorts
+// them.
+func compileErrors(t *testing.T, files ...CompilerTestFile) []string {
+	t.Helper()
+	targets := make([]string, 0, len(files))
+	for _, file := range files {
+		targets = append(targets, file.uri)
+	}
+	c, err := New(OptionWithExcReporter(exc.NewReporter(nil)), OptionWithFS(newTestFS(files...)))
+	require.NoError(t, err)
+	_, err = c.Compile(context.Background(), &idl.CompileRequest{Files: targets})
+	require.Error(t, err)
+	var caught MultiException
+	require.ErrorAs(t, err, &caught)
+
+	reported := make([]string, 0, len(caught))
+	for _, e := range caught {
+		reported = append(reported, e.Error())
+	}
+	return reported
+}
+
+func TestCompileProtobufDescriptorSet(t *testing.T) {
+	t.Parallel()
+
+	set, err := pb.Marshal(&descriptorpb.FileDescriptorSet{
+		File: []*descriptorpb.FileDescriptorProto{
+			{
+				Name:    pb.String("vendor/dep.proto"),
+				Package: pb.String("vendor"),
+				Syntax:  pb.String("proto3"),
+				MessageType: []*descriptorpb.DescriptorProto{
+					{Name: pb.String("Dep")},
+				},
+			},
+			{
+				Name:       pb.String("vendor/api.proto"),
+				Package:    pb.String("vendor"),
+				Syntax:     pb.String("proto3"),
+				Dependency: []string{"vendor/dep.proto"},
+				MessageType: []*descriptorpb.DescriptorProto{
+					{
+						Name: pb.String("Api"),
+						Field: []*descriptorpb.FieldDescriptorProto{
+							{
+								Name:     pb.String("dep"),
+								Number:   pb.Int32(1),
+								Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+								Type:     descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+								TypeName: pb.String(".vendor.Dep"),
+								JsonName: pb.String("dep"),
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name  string
+		files []string
+	}{
+		{
+			name:  "set as target",
+			files: []string{"/vendor.protoset", "/test.proto"},
+		},
+		{
+			name:  "set after importing target",
+			files: []string{"/test.proto", "/vendor.protoset"},
+		},
+	}
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			r := exc.NewReporter(nil)
+			c, err := New(
+				OptionWithExcReporter(r),
+				OptionWithFS(newTestFS(
+					CompilerTestFile{
+						kind:     idl.FileKindProtobufDesc,
+						uri:      "/vendor.protoset",
+						contents: string(set),
+					},
+					CompilerTestFile{
+						kind:     idl.FileKindProtobuf,
+						uri:      "/test.proto",
+						contents: "syntax = \"proto3\";\nimport \"vendor/api.proto\";\nimport \"vendor/dep.proto\";\nmessage Foo { vendor.Api api = 1; vendor.Dep dep = 2; }\n",
+					},
+				)),
+			)
+			require.NoError(t, err)
+			resp, err := c.Compile(context.Background(), &idl.CompileRequest{Files: testCase.files})
+			require.NoError(t, err, r.Reported())
+
+			uris := make([]string, 0, len(resp.Image.Modules))
+			for _, module := range resp.Image.Modules {
+				uris = append(uris, module.URI)
+			}
+			require.ElementsMatch(t, []string{"/protobuf.mglot", "/vendor/dep.proto", "/vendor/api.proto", "/test.proto"}, uris)
+		})
+	}
+}
+
+func TestCompileImage(t *testing.T) {
+	t.Parallel()
+
+	library := CompilerTestFile{
+		kind:     idl.FileKindMicroglot,
+		uri:      "/lib.mglot",
+		contents: "syntax = \"mglot0\"\nmodule = @13\nconst Answer :Int32 = 42\nstruct Foo { bar :Text }\nsdk Baz { Qux(foo :Foo) returns (:Int32) }\n",
+	}
+	r := exc.NewReporter(nil)
+	c, err := New(OptionWithExcReporter(r), OptionWithFS(newTestFS(library)))
+	require.NoError(t, err)
+	resp, err := c.Compile(context.Background(), &idl.CompileRequest{Files: []string{library.uri}})
+	require.NoError(t, err, r.Reported())
+
+	testCases := []struct {
+		name string
+		kind idl.FileKind
+		uri  string
+	}{
+		{name: "binary", kind: idl.FileKindMicroglotDescBinary, uri: "/lib.mglotbin"},
+		{name: "json", kind: idl.FileKindMicroglotDescJSON, uri: "/lib.mglotjson"},
+		{name: "proto", kind: idl.FileKindMicroglotDescProto, uri: "/lib.mglotproto"},
+	}
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			b, err := resp.Image.Marshal(testCase.kind)
+			require.NoError(t, err)
+
+			r := exc.NewReporter(nil)
+			c, err := New(
+				OptionWithExcReporter(r),
+				OptionWithFS(newTestFS(
+					CompilerTestFile{
+						kind:     testCase.kind,
+						uri:      testCase.uri,
+						contents: string(b),
+					},
+					CompilerTestFile{
+						kind:     idl.FileKindMicroglot,
+						uri:      "/test.mglot",
+						contents: "syntax = \"mglot0\"\nmodule = @14\nimport \"/lib.mglot\" as lib\nconst Question :Int32 = lib.Answer\nstruct Bar { foo :lib.Foo }\n",
+					},
+				)),
+			)
+			require.NoError(t, err)
+			out, err := c.Compile(context.Background(), &idl.CompileRequest{Files: []string{"/test.mglot", testCase.uri}})
+			require.NoError(t, err, r.Reported())
+
+			var lib *proto.Module
+			for _, module := range out.Image.Modules {
+				if module.URI == library.uri {
+					lib = module
+				}
+			}
+			require.NotNil(t, lib)
+			require.Len(t, lib.SDKs, 1)
+			require.Len(t, lib.Constants, 1)
+		})
+	}
+
+	t.Run("older Protobuf module", func(t *testing.T) {
+		t.Parallel()
+		// An image written by an older compiler has a Protobuf module that
+		// lacks the annotations added since, which must not replace the
+		// compiler's own.
+		image := &idl.Image{}
+		for _, module := range resp.Image.Modules {
+			module = pb.Clone(module).(*proto.Module)
+			if module.URI == protobufURI {
+				module.Annotations = module.Annotations[:1]
+			}
+			image.Modules = append(image.Modules, module)
+		}
+		b, err := image.Marshal(idl.FileKindMicroglotDescBinary)
+		require.NoError(t, err)
+
+		r := exc.NewReporter(nil)
+		c, err := New(OptionWithExcReporter(r), OptionWithFS(newTestFS(
+			CompilerTestFile{kind: idl.FileKindMicroglotDescBinary, uri: "/lib.mglotbin", contents: string(b)},
+			CompilerTestFile{
+				kind:     idl.FileKindMicroglot,
+				uri:      "/test.mglot",
+				contents: "syntax = \"mglot0\"\nmodule = @14 $(Protobuf.FileOptionsJavaPackage(\"com.example\"))\nimport \"/lib.mglot\" as lib\nstruct Bar { foo :lib.Foo }\n",
+			},
+		)))
+		require.NoError(t, err)
+		out, err := c.Compile(context.Background(), &idl.CompileRequest{Files: []string{"/lib.mglotbin", "/test.mglot"}})
+		require.NoError(t, err, r.Reported())
+		for _, module := range out.Image.Modules {
+			if module.URI == protobufURI {
+				require.Greater(t, len(module.Annotations), 1)
+			}
+		}
+	})
+}
+
+func TestCompileReportsSourceLocations(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+		file CompilerTestFile
+		code string
+		line int32
+	}{
+		{
+			name: "microglot unknown type",
+			file: CompilerTestFile{
+				kind:     idl.FileKindMicroglot,
+				uri:      "/test.mglot",
+				contents: "syntax = \"mglot0\"\nmodule = @13\n\nstruct Foo {\n    bar :Missing\n}\n",
+			},
+			code: exc.CodeUnknownType,
+			line: 5,
+		},
+		{
+			name: "microglot wrong constant value",
+			file: CompilerTestFile{
+				kind:     idl.FileKindMicroglot,
+				uri:      "/test.mglot",
+				contents: "syntax = \"mglot0\"\nmodule = @13\nconst Foo :Int32 = \"bar\"\n",
+			},
+			code: exc.CodeWrongTypeValue,
+			line: 3,
+		},
+		{
+			name: "protobuf unknown type",
+			file: CompilerTestFile{
+				kind:     idl.FileKindProtobuf,
+				uri:      "/test.proto",
+				contents: "syntax = \"proto3\";\n\nmessage Foo {\n  Missing bar = 1;\n}\n",
+			},
+			code: exc.CodeUnknownType,
+			line: 4,
+		},
+	}
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			r := exc.NewReporter(nil)
+			c, err := New(OptionWithExcReporter(r), OptionWithFS(newTestFS(testCase.file)))
+			require.NoError(t, err)
+			_, err = c.Compile(context.Background(), &idl.CompileRequest{Files: []string{testCase.file.uri}})
+			require.Error(t, err)
+
+			var found bool
+			for _, reported := range r.Reported() {
+				if reported.Code() == testCase.code {
+					found = true
+					require.Equal(t, testCase.file.uri, reported.Location().URI)
+					require.Equal(t, testCase.line, reported.Location().Line, reported)
+				}
+			}
+			require.True(t, found, r.Reported())
+		})
+	}
+}
+
+func TestCompileIsDeterministic(t *testing.T) {
+	t.Parallel()
+
+	compile := func(t *testing.T, files []CompilerTestFile, targets []string) (*idl.CompileResponse, error) {
+		c, err := New(OptionWithExcReporter(exc.NewReporter(nil)), OptionWithFS(newTestFS(files...)))
+		require.NoError(t, err)
+		return c.Compile(context.Background(), &idl.CompileRequest{Files: targets})
+	}
+
+	t.Run("image", func(t *testing.T) {
+		t.Parallel()
+		files := []CompilerTestFile{
+			{kind: idl.FileKindProtobuf, uri: "/c.proto", contents: "syntax = \"proto3\";\nmessage C { message Z {} message Y {} enum X { X_ZERO = 0; } enum W { W_ZERO = 0; } Z z = 1; Y y = 2; }\n"},
+			{kind: idl.FileKindProtobuf, uri: "/b.proto", contents: "syntax = \"proto3\";\nimport \"c.proto\";\nmessage B { C c = 1; }\n"},
+			{kind: idl.FileKindProtobuf, uri: "/a.proto", contents: "syntax = \"proto3\";\nimport \"b.proto\";\nimport \"c.proto\";\nmessage A { B b = 1; C c = 2; }\n"},
+		}
+		var first []byte
+		for x := 0; x < 20; x = x + 1 {
+			resp, err := compile(t, files, []string{"/c.proto", "/a.proto", "/b.proto"})
+			require.NoError(t, err)
+			uris := make([]string, 0, len(resp.Image.Modules))
+			for _, module := range resp.Image.Modules {
+				uris = append(uris, module.URI)
+			}
+			require.Equal(t, []string{"/a.proto", "/b.proto", "/c.proto", "/protobuf.mglot"}, uris)
+
+			set, err := resp.Image.ToFileDescriptorSet()
+			require.NoError(t, err)
+			for _, file := range set.File {
+				for _, message := range file.MessageType {
+					if message.GetName() != "C" {
+						continue
+					}
+					// nested types keep the order they were declared in
+					require.Equal(t, "Z", message.NestedType[0].GetName())
+					require.Equal(t, "Y", message.NestedType[1].GetName())
+					require.Equal(t, "X", message.EnumType[0].GetName())
+					require.Equal(t, "W", message.EnumType[1].GetName())
+				}
+			}
+			b, err := pb.MarshalOptions{Deterministic: true}.Marshal(set)
+			require.NoError(t, err)
+			if first == nil {
+				first = b
+			}
+			require.Equal(t, first, b)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		t.Parallel()
+		files := []CompilerTestFile{
+			{kind: idl.FileKindMicroglot, uri: "/a.mglot", contents: "syntax = \"mglot0\"\nmodule = @13\nimport \"/b.mglot\" as b\nstruct A { x :Missing }\n"},
+			{kind: idl.FileKindMicroglot, uri: "/b.mglot", contents: "syntax = \"mglot0\"\nmodule = @14\nstruct B { y :AlsoMissing }\n"},
+			{kind: idl.FileKindMicroglot, uri: "/c.mglot", contents: "syntax = \"mglot0\"\nmodule = @15\nstruct C { z :StillMissing }\n"},
+		}
+		var first string
+		for x := 0; x < 20; x = x + 1 {
+			_, err := compile(t, files, []string{"/c.mglot", "/a.mglot"})
+			require.Error(t, err)
+			var caught MultiException
+			require.ErrorAs(t, err, &caught)
+			require.Len(t, caught, 3)
+			if first == "" {
+				first = err.Error()
+			}
+			require.Equal(t, first, err.Error())
+		}
+	})
+}
+
+func TestCompileImportCycles(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		files    []CompilerTestFile
+		expected []string
+	}{
+		{
+			name: "microglot",
+			files: []CompilerTestFile{
+				{kind: idl.FileKindMicroglot, uri: "/a.mglot", contents: "syntax = \"mglot0\"\nmodule = @11\nimport \"/b.mglot\" as b\n"},
+				{kind: idl.FileKindMicroglot, uri: "/b.mglot", contents: "syntax = \"mglot0\"\nmodule = @12\nimport \"/c.mglot\" as c\n"},
+				{kind: idl.FileKindMicroglot, uri: "/c.mglot", contents: "syntax = \"mglot0\"\nmodule = @13\nimport \"/a.mglot\" as a\n"},
+			},
+			expected: []string{"/c.mglot:3:9 -- M0023: import cycle: /a.mglot -> /b.mglot -> /c.mglot -> /a.mglot"},
+		},
+		{
+			name: "self",
+			files: []CompilerTestFile{
+				{kind: idl.FileKindMicroglot, uri: "/a.mglot", contents: "syntax = \"mglot0\"\nmodule = @11\nimport \"/a.mglot\" as a\n"},
+			},
+			expected: []string{"/a.mglot:3:9 -- M0023: import cycle: /a.mglot -> /a.mglot"},
+		},
+		{
+			name: "protobuf",
+			files: []CompilerTestFile{
+				{kind: idl.FileKindProtobuf, uri: "/a.proto", contents: "syntax = \"proto3\";\nimport \"b.proto\";\n"},
+				{kind: idl.FileKindProtobuf, uri: "/b.proto", contents: "syntax = \"proto3\";\n\nimport \"a.proto\";\n"},
+			},
+			expected: []string{"/b.proto:3:1 -- M0023: import cycle: /a.proto -> /b.proto -> /a.proto"},
+		},
+	}
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, testCase.expected, compileErrors(t, testCase.files...))
+		})
+	}
+}
+
+func TestCompileReservations(t *testing.T) {
+	t.Parallel()
+
+	set, err := pb.Marshal(&descriptorpb.FileDescriptorSet{
+		File: []*descriptorpb.FileDescriptorProto{
+			{
+				Name:   pb.String("set.proto"),
+				Syntax: pb.String("proto2"),
+				MessageType: []*descriptorpb.DescriptorProto{
+					{
+						Name: pb.String("Foo"),
+						Field: []*descriptorpb.FieldDescriptorProto{
+							{Name: pb.String("b"), Number: pb.Int32(9), Label: descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(), Type: descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum()},
+							{Name: pb.String("old"), Number: pb.Int32(10), Label: descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(), Type: descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum()},
+						},
+						ReservedRange: []*descriptorpb.DescriptorProto_ReservedRange{{Start: pb.Int32(5), End: pb.Int32(10)}},
+						ReservedName:  []string{"old"},
+					},
+				},
+				EnumType: []*descriptorpb.EnumDescriptorProto{
+					{
+						Name: pb.String("Bar"),
+						Value: []*descriptorpb.EnumValueDescriptorProto{
+							{Name: pb.String("MINUS"), Number: pb.Int32(-2)},
+							{Name: pb.String("GONE"), Number: pb.Int32(2)},
+						},
+						ReservedRange: []*descriptorpb.EnumDescriptorProto_EnumReservedRange{{Start: pb.Int32(-3), End: pb.Int32(1)}},
+						ReservedName:  []string{"GONE"},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		file     CompilerTestFile
+		expected []string
+	}{
+		{
+			name: "microglot",
+			file: CompilerTestFile{kind: idl.FileKindMicroglot, uri: "/a.mglot", contents: `syntax = "mglot0"
+module = @11
+struct Foo {
+    reserved @2, @5 to @9, "old"
+    a :Text @1
+    b :Text @6
+    old :Text @10
+}
+enum Bar {
+    reserved @3, "Gone"
+    Gone @1
+    Three @3
+}
+api Baz {
+    reserved "Removed"
+    Removed(:Foo) returns (:Foo)
+}
+sdk Qux {
+    reserved @1
+    Call() @1
+}
+`},
+			expected: []string{
+				"/a.mglot:6:4 -- M0025: field Foo.b has the UID @6, which falls in the reserved range @5 to @9",
+				"/a.mglot:7:4 -- M0026: the name of field Foo.old is reserved",
+				"/a.mglot:11:4 -- M0026: the name of enumerant Bar.Gone is reserved",
+				"/a.mglot:12:4 -- M0025: enumerant Bar.Three has the UID @3, which is reserved",
+				"/a.mglot:16:4 -- M0026: the name of method Baz.Removed is reserved",
+				"/a.mglot:20:4 -- M0025: method Qux.Call has the UID @1, which is reserved",
+			},
+		},
+		{
+			name: "protobuf",
+			file: CompilerTestFile{kind: idl.FileKindProtobuf, uri: "/a.proto", contents: "syntax = \"proto3\";\nmessage Foo {\n  reserved 5 to 9;\n  string b = 9;\n}\n"},
+			expected: []string{
+				"/a.proto:4:14 -- M0006: /a.proto:4:14: message Foo: field b is using tag 9 which is in reserved range 5 to 9",
+			},
+		},
+		{
+			name: "protobuf descriptor set",
+			file: CompilerTestFile{kind: idl.FileKindProtobufDesc, uri: "/set.protoset", contents: string(set)},
+			expected: []string{
+				"/set.proto:0:0 -- M0025: enumerant Bar.MINUS has the UID @18446744073709551614, which falls in the reserved range @18446744073709551613 to @18446744073709551615",
+				"/set.proto:0:0 -- M0025: field Foo.b has the UID @9, which falls in the reserved range @5 to @9",
+				"/set.proto:0:0 -- M0026: the name of enumerant Bar.GONE is reserved",
+				"/set.proto:0:0 -- M0026: the name of field Foo.old is reserved",
+			},
+		},
+		{
+			name: "enum range outside of an int32",
+			file: CompilerTestFile{kind: idl.FileKindMicroglot, uri: "/a.mglot", contents: "syntax = \"mglot0\"\nmodule = @11\nenum Bar {\n    reserved @5 to @4294967296\n    One @1\n}\n"},
+			expected: []string{
+				"/a.mglot:3:5 -- M0027: the reserved range @5 to @4294967296 of Bar isn't within the range of an int32, which enum values must be",
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			reported := compileErrors(t, testCase.file)
+			require.Equal(t, testCase.expected, reported)
+		})
+	}
+
+	t.Run("reserved as a name", func(t *testing.T) {
+		t.Parallel()
+		file := CompilerTestFile{kind: idl.FileKindMicroglot, uri: "/a.mglot", contents: `syntax = "mglot0"
+module = @11
+struct Foo {
+    reserved :Text @1
+}
+enum Bar {
+    reserved @1
+    Two @2
+}
+enum Baz {
+    reserved @1 to @1
+    One @2
+}
+api Qux {
+    reserved(:Foo) returns (:Foo) @1
+}
+`}
+		r := exc.NewReporter(nil)
+		c, err := New(OptionWithExcReporter(r), OptionWithFS(newTestFS(file)))
+		require.NoError(t, err)
+		resp, err := c.Compile(context.Background(), &idl.CompileRequest{Files: []string{file.uri}})
+		require.NoError(t, err, r.Reported())
+		for _, module := range resp.Image.Modules {
+			if module.URI != file.uri {
+				continue
+			}
+			require.Equal(t, "reserved", module.Structs[0].Fields[0].Name)
+			require.True(t, slices.ContainsFunc(module.Enums[0].Enumerants, func(e *proto.Enumerant) bool { return e.Name == "reserved" }))
+			require.Empty(t, module.Enums[0].Reserved)
+			require.Len(t, module.Enums[1].Reserved, 1)
+			require.Equal(t, "reserved", module.APIs[0].Methods[0].Name)
+		}
+	})
+
+	t.Run("descriptor set output", func(t *testing.T) {
+		t.Parallel()
+		file := CompilerTestFile{kind: idl.FileKindProtobuf, uri: "/a.proto", contents: "syntax = \"proto2\";\nmessage Foo {\n  reserved 2, 5 to max;\n  reserved \"old\";\n  optional string a = 1;\n}\nenum Bar {\n  reserved -2 to 1;\n  reserved \"GONE\";\n  TWO = 2;\n}\n"}
+		c, err := New(OptionWithExcReporter(exc.NewReporter(nil)), OptionWithFS(newTestFS(file)))
+		require.NoError(t, err)
+		resp, err := c.Compile(context.Background(), &idl.CompileRequest{Files: []string{file.uri}})
+		require.NoError(t, err)
+		set, err := resp.Image.ToFileDescriptorSet()
+		require.NoError(t, err)
+		var found bool
+		for _, f := range set.File {
+			if f.GetName() != "a.proto" {
+				continue
+			}
+			found = true
+			message := f.MessageType[0]
+			require.Equal(t, []string{"old"}, message.ReservedName)
+			var ranges [][2]int32
+			for _, r := range message.ReservedRange {
+				ranges = append(ranges, [2]int32{r.GetStart(), r.GetEnd()})
+			}
+			require.Equal(t, [][2]int32{{2, 3}, {5, 536870912}}, ranges)
+			enum := f.EnumType[0]
+			require.Equal(t, []string{"GONE"}, enum.ReservedName)
+			ranges = nil
+			for _, r := range enum.ReservedRange {
+				ranges = append(ranges, [2]int32{r.GetStart(), r.GetEnd()})
+			}
+			require.Equal(t, [][2]int32{{-2, -1}, {0, 1}}, ranges)
+		}
+		require.True(t, found)
+	})
+}
+
+func TestCompileAnnotationScopes(t *testing.T) {
+	t.Parallel()
+
+	file := CompilerTestFile{kind: idl.FileKindMicroglot, uri: "/a.mglot", contents: `syntax = "mglot0"
+module = @11
+annotation Foo(module, const) :Text
+struct Bar {
+    baz :Text $(Foo("x"))
+} $(Protobuf.JsonName("x"))
+`}
+	reported := compileErrors(t, file)
+	require.Equal(t, []string{
+		"/a.mglot:5:16 -- M0028: annotation Foo can't be applied to field (allowed scopes: module, const)",
+		"/a.mglot:6:4 -- M0028: annotation JsonName can't be applied to struct (allowed scopes: field)",
+	}, reported)
+}
+
+func TestCompileExtends(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		files    []CompilerTestFile
+		expected []string
+	}{
+		{
+			name: "diamond",
+			files: []CompilerTestFile{{kind: idl.FileKindMicroglot, uri: "/a.mglot", contents: `syntax = "mglot0"
+module = @11
+struct Empty {}
+api Health {
+    Check(:Empty) returns (:Empty)
+}
+api Left extends (:Health) {}
+api Right extends (:Health) {}
+api Both extends (:Left, :Right) {
+    Call(:Empty) returns (:Empty)
+}
+`}},
+		},
+		{
+			name: "imported",
+			files: []CompilerTestFile{
+				{kind: idl.FileKindMicroglot, uri: "/a.mglot", contents: `syntax = "mglot0"
+module = @11
+import "/b.mglot" as b
+sdk Foo extends (:b.Bar) {
+    Call()
+}
+`},
+				{kind: idl.FileKindMicroglot, uri: "/b.mglot", contents: `syntax = "mglot0"
+module = @12
+sdk Bar {
+    Call()
+}
+`},
+			},
+			expected: []string{
+				"/a.mglot:5:4 -- M0030: method Call of SDK Foo is declared by both Foo and Bar (/b.mglot)",
+			},
+		},
+		{
+			name: "collisions",
+			files: []CompilerTestFile{{kind: idl.FileKindMicroglot, uri: "/a.mglot", contents: `syntax = "mglot0"
+module = @11
+struct Empty {}
+api Health {
+    Check(:Empty) returns (:Empty)
+}
+api Status {
+    Check(:Empty) returns (:Empty)
+}
+api Foo extends (:Health) {
+    Check(:Empty) returns (:Empty)
+}
+api Bar extends (:Health, :Status) {}
+sdk Base {
+    Call()
+}
+sdk Derived extends (:Base) {
+    Call()
+}
+`}},
+			expected: []string{
+				"/a.mglot:11:4 -- M0030: method Check of API Foo is declared by both Foo and Health",
+				"/a.mglot:13:27 -- M0030: method Check of API Bar is declared by both Health and Status",
+				"/a.mglot:18:4 -- M0030: method Call of SDK Derived is declared by both Derived and Base",
+			},
+		},
+		{
+			name: "self",
+			files: []CompilerTestFile{{kind: idl.FileKindMicroglot, uri: "/a.mglot", contents: `syntax = "mglot0"
+module = @11
+api Foo extends (:Bar) {}
+api Bar extends (:Baz) {}
+api Baz extends (:Foo) {}
+sdk Self extends (:Self) {}
+`}},
+			expected: []string{
+				"/a.mglot:3:18 -- M0029: API Foo extends itself: Foo -> Bar -> Baz -> Foo",
+				"/a.mglot:4:18 -- M0029: API Bar extends itself: Bar -> Baz -> Foo -> Bar",
+				"/a.mglot:5:18 -- M0029: API Baz extends itself: Baz -> Foo -> Bar -> Baz",
+				"/a.mglot:6:19 -- M0029: SDK Self extends itself: Self -> Self",
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			if testCase.expected != nil {
+				require.Equal(t, testCase.expected, compileErrors(t, testCase.files...))
+				return
+			}
+			r := exc.NewReporter(nil)
+			c, err := New(OptionWithExcReporter(r), OptionWithFS(newTestFS(testCase.files...)))
+			require.NoError(t, err)
+			_, err = c.Compile(context.Background(), &idl.CompileRequest{Files: []string{testCase.files[0].uri}})
+			require.NoError(t, err, r.Reported())
+		})
+	}
+}
+
+func TestCompileInvalidUIDs(t *testing.T) {
+	t.Parallel()
+
+	set, err := pb.Marshal(&descriptorpb.FileDescriptorSet{
+		File: []*descriptorpb.FileDescriptorProto{
+			{
+				Name:   pb.String("set.proto"),
+				Syntax: pb.String("proto2"),
+				MessageType: []*descriptorpb.DescriptorProto{
+					{
+						Name: pb.String("Foo"),
+						Field: []*descriptorpb.FieldDescriptorProto{
+							{Name: pb.String("a"), Number: pb.Int32(0), Label: descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(), Type: descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum()},
+							{Name: pb.String("b"), Number: pb.Int32(19000), Label: descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(), Type: descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum()},
+						},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		file     CompilerTestFile
+		expected []string
+	}{
+		{
+			name: "microglot",
+			file: CompilerTestFile{kind: idl.FileKindMicroglot, uri: "/a.mglot", contents: `syntax = "mglot0"
+module = @11
+struct Foo {
+    a :Text @0
+    b :Text @19500
+    c :Text @536870912
+    union Reserved { d :Text @1 } @19501
+    union Large { e :Text @2 } @536870913
+}
+enum Bar {
+    Small @2147483647
+    Big @2147483648
+}
+`},
+			expected: []string{
+				"/a.mglot:4:4 -- M0027: field Foo.a has the UID @0 but field numbers must be positive integers",
+				"/a.mglot:5:4 -- M0027: field Foo.b has the UID @19500 but field numbers 19000 through 19999 are reserved for the protocol buffer library implementation",
+				"/a.mglot:6:4 -- M0027: field Foo.c has the UID @536870912 but field numbers cannot be greater than 536870911",
+				"/a.mglot:12:4 -- M0027: enumerant Bar.Big has the UID @2147483648 but enum values must be within the range of an int32",
+			},
+		},
+		{
+			name: "protobuf descriptor set",
+			file: CompilerTestFile{kind: idl.FileKindProtobufDesc, uri: "/set.protoset", contents: string(set)},
+			expected: []string{
+				"/set.proto:0:0 -- M0027: field Foo.a has the UID @0 but field numbers must be positive integers",
+				"/set.proto:0:0 -- M0027: field Foo.b has the UID @19000 but field numbers 19000 through 19999 are reserved for the protocol buffer library implementation",
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			reported := compileErrors(t, testCase.file)
+			require.Equal(t, testCase.expected, reported)
+		})
+	}
+
+	t.Run("generated", func(t *testing.T) {
+		t.Parallel()
+		for i := 0; i < 100000; i = i + 1 {
+			uid := newAttributeUID(11, fmt.Sprintf("field%d", i))
+			require.Empty(t, fieldUIDProblem(uid), uid)
+		}
+	})
+}
+
+const customOptionsProto = `syntax = "proto3";
+package opts;
+import "google/protobuf/descriptor.proto";
+enum Level {
+  LOW = 0;
+  HIGH = 1;
+}
+message Rules {
+  string pattern = 1;
+  repeated string tags = 2;
+  Rules nested = 3;
+  optional uint32 max = 4;
+  sint32 delta = 5;
+  repeated fixed64 sizes = 6;
+}
+extend google.protobuf.FileOptions {
+  double weight = 50005;
+}
+extend google.protobuf.MessageOptions {
+  Level level = 50001;
+}
+extend google.protobuf.FieldOptions {
+  Rules rules = 50001;
+  string tag = 50002;
+  repeated int32 ids = 50003;
+}
+extend google.protobuf.ServiceOptions {
+  bool internal = 50004;
+}
+`
+
+func TestCompileCustomOptions(t *testing.T) {
+	t.Parallel()
+
+	const source = `syntax = "proto3";
+package a;
+import "options.proto";
+option (opts.weight) = 1.5;
+message Foo {
+  option (opts.level) = HIGH;
+  string name = 1 [(opts.tag) = "n", (opts.ids) = 1, (opts.ids) = -2, (opts.rules) = {tags: ["y", "z"] nested: {max: 3} delta: -3 sizes: [1, 2]}, (opts.rules).pattern = "^a", (opts.rules).tags = "x"];
+}
+service S {
+  option (opts.internal) = true;
+}
+`
+	r := exc.NewReporter(nil)
+	c, err := New(OptionWithExcReporter(r), OptionWithFS(newTestFS(
+		CompilerTestFile{kind: idl.FileKindProtobuf, uri: "/options.proto", contents: customOptionsProto},
+		CompilerTestFile{kind: idl.FileKindProtobuf, uri: "/a.proto", contents: source},
+	)))
+	require.NoError(t, err)
+	resp, err := c.Compile(context.Background(), &idl.CompileRequest{Files: []string{"/a.proto"}})
+	require.NoError(t, err, r.Reported())
+	set, err := resp.Image.ToFileDescriptorSet()
+	require.NoError(t, err)
+	_, err = protodesc.NewFiles(set)
+	require.NoError(t, err)
+
+	// The options must decode to what protoc produces for the same source.
+	expected, err := (&protocompile.Compiler{
+		Resolver: protocompile.WithStandardImports(&protocompile.SourceResolver{
+			Accessor: protocompile.SourceAccessorFromMap(map[string]string{
+				"options.proto": customOptionsProto,
+				"a.proto":       source,
+			}),
+		}),
+	}).Compile(context.Background(), "a.proto", "options.proto")
+	require.NoError(t, err)
+	types := new(protoregistry.Types)
+	extensions := expected[1].Extensions()
+	for i := 0; i < extensions.Len(); i = i + 1 {
+		require.NoError(t, types.RegisterExtension(dynamicpb.NewExtensionType(extensions.Get(i))))
+	}
+	want := protodesc.ToFileDescriptorProto(expected[0])
+
+	var got *descriptorpb.FileDescriptorProto
+	for _, file := range set.File {
+		if file.GetName() == "a.proto" {
+			got = file
+		}
+	}
+	require.NotNil(t, got)
+	requireSameOptions := func(want pb.Message, got pb.Message) {
+		decode := func(m pb.Message) pb.Message {
+			b, err := pb.Marshal(m)
+			require.NoError(t, err)
+			decoded := m.ProtoReflect().New().Interface()
+			require.NoError(t, pb.UnmarshalOptions{Resolver: types}.Unmarshal(b, decoded))
+			return decoded
+		}
+		require.NotEmpty(t, got.ProtoReflect().GetUnknown())
+		require.True(t, pb.Equal(decode(want), decode(got)), "want %v, got %v", decode(want), decode(got))
+	}
+	requireSameOptions(want.Options, got.Options)
+	requireSameOptions(want.MessageType[0].Options, got.MessageType[0].Options)
+	requireSameOptions(want.MessageType[0].Field[0].Options, got.MessageType[0].Field[0].Options)
+	requireSameOptions(want.Service[0].Options, got.Service[0].Options)
+}
+
+func TestCompileInvalidCustomOptions(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		options  string
+		expected string
+	}{
+		{
+			name:     "wrong type",
+			options:  `(opts.tag) = 1`,
+			expected: "/a.proto:4:33 -- M0031: option (opts.tag): expecting Text, found integer",
+		},
+		{
+			name:     "out of range",
+			options:  `(opts.rules).max = 1, (opts.ids) = 3000000000`,
+			expected: "/a.proto:4:55 -- M0031: option (opts.ids): 3000000000 is out of range for Int32",
+		},
+		{
+			name:     "unknown field",
+			options:  `(opts.rules) = {bogus: 1}`,
+			expected: "/a.proto:4:43 -- M0031: option (opts.rules): Rules has no field named bogus",
+		},
+		{
+			name:     "set more than once",
+			options:  `(opts.tag) = "a", (opts.tag) = "b"`,
+			expected: "/a.proto:4:51 -- M0031: option (opts.tag): a value that isn't repeated is set more than once",
+		},
+	}
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			reported := compileErrors(t,
+				CompilerTestFile{kind: idl.FileKindProtobuf, uri: "/options.proto", contents: customOptionsProto},
+				CompilerTestFile{kind: idl.FileKindProtobuf, uri: "/a.proto", contents: "syntax = \"proto3\";\nimport \"options.proto\";\nmessage Foo {\n  string name = 1 [" + testCase.options + "];\n}\n"},
+			)
+			require.Equal(t, []string{testCase.expected}, reported)
+		})
+	}
+}
+
+func TestCompileAnnotationsAsCustomOptions(t *testing.T) {
+	t.Parallel()
+
+	file := CompilerTestFile{
+		kind: idl.FileKindMicroglot,
+		uri:  "/lib.mglot",
+		contents: `syntax = "mglot0"
+module = @13
+struct Limits {
+    min :Int32 @1
+    max :Presence<:Int32> @2
+}
+annotation Tag(field, struct) :Text @5000
+annotation Sizes(field) :List<:Int32> @5001
+annotation Limit(api) :Limits @5002
+annotation Labels(field) :Map<:Text, :Text> @5003
+annotation Note(const) :Text @1
+annotation Small(field) :Text @5
+annotation Implementation(struct) :Text @19001
+struct Foo {
+    name :Text @1 $(Tag("n"), Sizes([1, 2]), Small("s"))
+} $(Tag("foo"), Implementation("i"))
+api Svc {
+    Get(:Foo) returns (:Foo)
+} $(Limit({min: 1, max: 10}))
+`,
+	}
+	r := exc.NewReporter(nil)
+	c, err := New(OptionWithExcReporter(r), OptionWithFS(newTestFS(file)))
+	require.NoError(t, err)
+	resp, err := c.Compile(context.Background(), &idl.CompileRequest{Files: []string{file.uri}})
+	require.NoError(t, err, r.Reported())
+	set, err := resp.Image.ToFileDescriptorSet()
+	require.NoError(t, err)
+	files, err := protodesc.NewFiles(set)
+	require.NoError(t, err)
+
+	types := new(protoregistry.Types)
+	extension := func(name string, number protoreflect.FieldNumber) protoreflect.ExtensionType {
+		descriptor, err := files.FindDescriptorByName(protoreflect.FullName(name))
+		require.NoError(t, err, name)
+		extensionType := dynamicpb.NewExtensionType(descriptor.(protoreflect.ExtensionDescriptor))
+		require.Equal(t, number, extensionType.TypeDescriptor().Number())
+		require.NoError(t, types.RegisterExtension(extensionType))
+		return extensionType
+	}
+	tagField := extension("lib.Tag_Field", 5000)
+	tagMessage := extension("lib.Tag_Message", 5000)
+	sizes := extension("lib.Sizes", 5001)
+	limit := extension("lib.Limit", 5002)
+	_, err = files.FindDescriptorByName("lib.Labels")
+	require.ErrorIs(t, err, protoregistry.NotFound)
+	_, err = files.FindDescriptorByName("lib.Note")
+	require.ErrorIs(t, err, protoregistry.NotFound)
+	// The options messages don't accept these numbers for their extensions.
+	_, err = files.FindDescriptorByName("lib.Small")
+	require.ErrorIs(t, err, protoregistry.NotFound)
+	_, err = files.FindDescriptorByName("lib.Implementation")
+	require.ErrorIs(t, err, protoregistry.NotFound)
+
+	decode := func(m pb.Message) protoreflect.Message {
+		b, err := pb.Marshal(m)
+		require.NoError(t, err)
+		decoded := m.ProtoReflect().New().Interface()
+		require.NoError(t, pb.UnmarshalOptions{Resolver: types}.Unmarshal(b, decoded))
+		return decoded.ProtoReflect()
+	}
+	var lib *descriptorpb.FileDescriptorProto
+	for _, file := range set.File {
+		if file.GetName() == "lib.mglot" {
+			lib = file
+		}
+	}
+	require.NotNil(t, lib)
+	require.Contains(t, lib.Dependency, "google/protobuf/descriptor.proto")
+
+	var foo *descriptorpb.DescriptorProto
+	for _, messageType := range lib.MessageType {
+		if messageType.GetName() == "Foo" {
+			foo = messageType
+		}
+	}
+	require.NotNil(t, foo)
+	require.Equal(t, "foo", decode(foo.Options).Get(tagMessage.TypeDescriptor()).String())
+	fieldOptions := decode(foo.Field[0].Options)
+	require.Equal(t, "n", fieldOptions.Get(tagField.TypeDescriptor()).String())
+	list := fieldOptions.Get(sizes.TypeDescriptor()).List()
+	require.Equal(t, 2, list.Len())
+	require.Equal(t, int64(1), list.Get(0).Int())
+	require.Equal(t, int64(2), list.Get(1).Int())
+
+	limits := decode(lib.Service[0].Options).Get(limit.TypeDescriptor()).Message()
+	require.Equal(t, int64(1), limits.Get(limits.Descriptor().Fields().ByName("min")).Int())
+	require.Equal(t, int64(10), limits.Get(limits.Descriptor().Fields().ByName("max")).Int())
+}
+
+func TestCompileInvalidExtensionNumbers(t *testing.T) {
+	t.Parallel()
+
+	reported := compileErrors(t,
+		CompilerTestFile{kind: idl.FileKindMicroglot, uri: "/a.mglot", contents: `syntax = "mglot0"
+module = @11
+annotation Constant(const) :Text @6
+annotation First(field) :Text @6000
+`},
+		CompilerTestFile{kind: idl.FileKindMicroglot, uri: "/b.mglot", contents: `syntax = "mglot0"
+module = @12
+annotation Second(field, struct) :Text @6000
+`},
+	)
+	require.Equal(t, []string{
+		"/b.mglot:3:11 -- M0032: annotation Second has the protobuf extension number 6000 of google.protobuf.FieldOptions, which annotation First (/a.mglot) already has",
+	}, reported)
+}
+
+func TestCompileStreamingMethods(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+		file CompilerTestFile
+	}{
+		{
+			name: "protobuf",
+			file: CompilerTestFile{kind: idl.FileKindProtobuf, uri: "/a.proto", contents: `syntax = "proto3";
+message M {}
+service S {
+  rpc Unary(M) returns (M);
+  rpc Client(stream M) returns (M);
+  rpc Server(M) returns (stream M);
+  rpc Bidi(stream M) returns (stream M);
+}
+`},
+		},
+		{
+			name: "microglot",
+			file: CompilerTestFile{kind: idl.FileKindMicroglot, uri: "/a.mglot", contents: `syntax = "mglot0"
+module = @11
+struct M {}
+api S {
+    Unary(:M) returns (:M)
+    Client(stream :M) returns (:M)
+    Server(:M) returns (stream :M)
+    Bidi(stream :M) returns (stream :M)
+}
+`},
+		},
+	}
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			r := exc.NewReporter(nil)
+			c, err := New(OptionWithExcReporter(r), OptionWithFS(newTestFS(testCase.file)))
+			require.NoError(t, err)
+			resp, err := c.Compile(context.Background(), &idl.CompileRequest{Files: []string{testCase.file.uri}})
+			require.NoError(t, err, r.Reported())
+			set, err := resp.Image.ToFileDescriptorSet()
+			require.NoError(t, err)
+
+			var methods []*descriptorpb.MethodDescriptorProto
+			for _, file := range set.File {
+				if file.GetName() == idl.URIToProtoFile(testCase.file.uri) {
+					methods = file.Service[0].Method
+				}
+			}
+			require.Len(t, methods, 4)
+			for _, method := range methods {
+				client := method.GetName() == "Client" || method.GetName() == "Bidi"
+				server := method.GetName() == "Server" || method.GetName() == "Bidi"
+				require.Equal(t, client, method.GetClientStreaming(), method.GetName())
+				require.Equal(t, server, method.GetServerStreaming(), method.GetName())
+			}
+		})
+	}
+}
+
+func TestCompileExtensions(t *testing.T) {
+	t.Parallel()
+
+	sources := map[string]string{
+		"a.proto": `syntax = "proto2";
+package a;
+import "google/protobuf/descriptor.proto";
+message Foo {
+  optional int32 id = 1;
+  extensions 100 to 199, 500;
+  extend Foo {
+    optional string nested = 101;
+  }
+}
+extend Foo {
+  optional int32 x = 100;
+}
+extend google.protobuf.FieldOptions {
+  optional string tag = 50000;
+}
+extend Foo {
+  repeated Foo foos = 102;
+}
+`,
+		"b.proto": `syntax = "proto2";
+package b;
+import "a.proto";
+extend a.Foo {
+  optional bool y = 500;
+}
+`,
+	}
+	r := exc.NewReporter(nil)
+	c, err := New(OptionWithExcReporter(r), OptionWithFS(newTestFS(
+		CompilerTestFile{kind: idl.FileKindProtobuf, uri: "/a.proto", contents: sources["a.proto"]},
+		CompilerTestFile{kind: idl.FileKindProtobuf, uri: "/b.proto", contents: sources["b.proto"]},
+	)))
+	require.NoError(t, err)
+	resp, err := c.Compile(context.Background(), &idl.CompileRequest{Files: []string{"/a.proto", "/b.proto"}})
+	require.NoError(t, err, r.Reported())
+	set, err := resp.Image.ToFileDescriptorSet()
+	require.NoError(t, err)
+	_, err = protodesc.NewFiles(set)
+	require.NoError(t, err)
+
+	// The files must come out as protoc produces them.
+	expected, err := (&protocompile.Compiler{
+		Resolver: protocompile.WithStandardImports(&protocompile.SourceResolver{
+			Accessor: protocompile.SourceAccessorFromMap(sources),
+		}),
+	}).Compile(context.Background(), "a.proto", "b.proto")
+	require.NoError(t, err)
+	for _, file := range expected {
+		want := protodesc.ToFileDescriptorProto(file)
+		var got *descriptorpb.FileDescriptorProto
+		for _, f := range set.File {
+			if f.GetName() == want.GetName() {
+				got = f
+			}
+		}
+		require.NotNil(t, got, want.GetName())
+		require.Equal(t, want.Syntax, got.Syntax)
+		for _, messageType := range want.MessageType {
+			require.True(t, slices.ContainsFunc(got.MessageType, func(m *descriptorpb.DescriptorProto) bool {
+				return pb.Equal(messageType, m)
+			}), "want %v, got %v", messageType, got.MessageType)
+		}
+		require.Len(t, got.Extension, len(want.Extension))
+		for i, extension := range want.Extension {
+			// Annotations, which the extensions of the options messages are
+			// converted to, don't record a JSON name.
+			if strings.HasPrefix(extension.GetExtendee(), ".google.protobuf.") {
+				extension.JsonName = nil
+			}
+			require.True(t, pb.Equal(extension, got.Extension[i]), "want %v, got %v", extension, got.Extension[i])
+		}
+	}
+}
+
+func TestCompileDescriptorProtoTypes(t *testing.T) {
+	t.Parallel()
+
+	sources := map[string]string{
+		"a.proto": `syntax = "proto3";
+package a;
+message A {}
+`,
+		"b.proto": `syntax = "proto2";
+package b;
+import "a.proto";
+import "google/protobuf/descriptor.proto";
+import "c.proto";
+message Plugin {
+  optional a.A a = 1;
+  optional google.protobuf.FileDescriptorProto file = 2;
+  optional c.C c = 3;
+  optional google.protobuf.FieldOptions.JSType js_type = 4;
+}
+extend google.protobuf.FieldOptions {
+  optional string tag = 50000;
+}
+`,
+		"c.proto": `syntax = "proto3";
+package c;
+message C {}
+`,
+	}
+	r := exc.NewReporter(nil)
+	c, err := New(OptionWithExcReporter(r), OptionWithFS(newTestFS(
+		CompilerTestFile{kind: idl.FileKindProtobuf, uri: "/a.proto", contents: sources["a.proto"]},
+		CompilerTestFile{kind: idl.FileKindProtobuf, uri: "/b.proto", contents: sources["b.proto"]},
+		CompilerTestFile{kind: idl.FileKindProtobuf, uri: "/c.proto", contents: sources["c.proto"]},
+	)))
+	require.NoError(t, err)
+	resp, err := c.Compile(context.Background(), &idl.CompileRequest{Files: []string{"/b.proto"}})
+	require.NoError(t, err, r.Reported())
+	set, err := resp.Image.ToFileDescriptorSet()
+	require.NoError(t, err)
+	_, err = protodesc.NewFiles(set)
+	require.NoError(t, err)
+
+	// The imports and field types must come out as protoc produces them.
+	expected, err := (&protocompile.Compiler{
+		Resolver: protocompile.WithStandardImports(&protocompile.SourceResolver{
+			Accessor: protocompile.SourceAccessorFromMap(sources),
+		}),
+	}).Compile(context.Background(), "b.proto")
+	require.NoError(t, err)
+	want := protodesc.ToFileDescriptorProto(expected[0])
+	var got *descriptorpb.FileDescriptorProto
+	for _, file := range set.File {
+		if file.GetName() == "b.proto" {
+			got = file
+		}
+	}
+	require.NotNil(t, got)
+	require.Equal(t, want.Dependency, got.Dependency)
+	require.Len(t, got.MessageType, 1)
+	for i, field := range want.MessageType[0].Field {
+		require.Equal(t, field.GetTypeName(), got.MessageType[0].Field[i].GetTypeName())
+	}
+}
+
+func TestCompileInvalidExtensions(t *testing.T) {
+	t.Parallel()
+
+	reported := compileErrors(t,
+		CompilerTestFile{kind: idl.FileKindProtobuf, uri: "/a.proto", contents: "syntax = \"proto2\";\npackage a;\nmessage Foo {\n  extensions 100 to 199;\n}\nextend Foo {\n  optional int32 x = 100;\n}\n"},
+		CompilerTestFile{kind: idl.FileKindProtobuf, uri: "/b.proto", contents: "syntax = \"proto2\";\npackage b;\nimport \"a.proto\";\nextend a.Foo {\n  optional int32 y = 100;\n  optional int32 z = 200;\n}\n"},
+		CompilerTestFile{kind: idl.FileKindProtobuf, uri: "/c.proto", contents: "syntax = \"proto2\";\npackage c;\nimport \"google/protobuf/descriptor.proto\";\nmessage Bar {\n  extend google.protobuf.FieldOptions {\n    optional string tag = 50000;\n  }\n}\n"},
+	)
+	require.Equal(t, []string{
+		"/b.proto:5:3 -- M0032: extension y of Foo has the UID @100, which extension x (/a.proto) already has",
+		"/b.proto:6:3 -- M0027: extension z of Foo has the UID @200, which isn't within an extension range of Foo",
+		"/c.proto:6:5 -- M0021: extension tag of google.protobuf.FieldOptions is declared within Bar, which isn't supported; extensions of the protobuf options messages must be declared at the top level of a file",
+	}, reported)
+}
+
+func TestCompileRequiredAndGroups(t *testing.T) {
+	t.Parallel()
+
+	t.Run("protobuf", func(t *testing.T) {
+		t.Parallel()
+		const source = `syntax = "proto2";
+package a;
+message Foo {
+  required int32 id = 1;
+  repeated group Item = 2 {
+    optional int32 n = 3;
+  }
+  optional group Result = 4 {
+    required string url = 5;
+  }
+}
+`
+		r := exc.NewReporter(nil)
+		c, err := New(OptionWithExcReporter(r), OptionWithFS(newTestFS(
+			CompilerTestFile{kind: idl.FileKindProtobuf, uri: "/a.proto", contents: source},
+		)))
+		require.NoError(t, err)
+		resp, err := c.Compile(context.Background(), &idl.CompileRequest{Files: []string{"/a.proto"}})
+		require.NoError(t, err, r.Reported())
+		set, err := resp.Image.ToFileDescriptorSet()
+		require.NoError(t, err)
+		_, err = protodesc.NewFiles(set)
+		require.NoError(t, err)
+
+		// The messages must come out as protoc produces them.
+		expected, err := (&protocompile.Compiler{
+			Resolver: &protocompile.SourceResolver{
+				Accessor: protocompile.SourceAccessorFromMap(map[string]string{"a.proto": source}),
+			},
+		}).Compile(context.Background(), "a.proto")
+		require.NoError(t, err)
+		want := protodesc.ToFileDescriptorProto(expected[0])
+		var got *descriptorpb.FileDescriptorProto
+		for _, file := range set.File {
+			if file.GetName() == "a.proto" {
+				got = file
+			}
+		}
+		require.NotNil(t, got)
+		require.Len(t, got.MessageType, 1)
+		require.True(t, pb.Equal(want.MessageType[0], got.MessageType[0]), "want %v, got %v", want.MessageType[0], got.MessageType[0])
+	})
+
+	t.Run("microglot", func(t *testing.T) {
+		t.Parallel()
+		r := exc.NewReporter(nil)
+		c, err := New(OptionWithExcReporter(r), OptionWithFS(newTestFS(
+			CompilerTestFile{kind: idl.FileKindMicroglot, uri: "/a.mglot", contents: `syntax = "mglot0"
+module = @11 $(Protobuf.Syntax("proto2"))
+struct Foo {
+    a :Int32 @1 $(Protobuf.Required(true))
+    b :Bar @2 $(Protobuf.Group(true))
+}
+struct Bar {
+    c :Text @1
+}
+`},
+		)))
+		require.NoError(t, err)
+		resp, err := c.Compile(context.Background(), &idl.CompileRequest{Files: []string{"/a.mglot"}})
+		require.NoError(t, err, r.Reported())
+		set, err := resp.Image.ToFileDescriptorSet()
+		require.NoError(t, err)
+		var got *descriptorpb.FileDescriptorProto
+		for _, file := range set.File {
+			if file.GetName() == "a.mglot" {
+				got = file
+			}
+		}
+		require.NotNil(t, got)
+		require.Nil(t, got.Syntax)
+		fields := got.MessageType[0].Field
+		require.Equal(t, descriptorpb.FieldDescriptorProto_LABEL_REQUIRED, fields[0].GetLabel())
+		require.Equal(t, descriptorpb.FieldDescriptorProto_TYPE_GROUP, fields[1].GetType())
+	})
+
+	t.Run("invalid groups", func(t *testing.T) {
+		t.Parallel()
+		reported := compileErrors(t,
+			CompilerTestFile{kind: idl.FileKindMicroglot, uri: "/a.mglot", contents: `syntax = "mglot0"
+module = @11 $(Protobuf.Syntax("proto2"))
+struct Foo {
+    a :Text @1 $(Protobuf.Group(true))
+    b :List<:Int32> @2 $(Protobuf.Group(true))
+    c :List<:Foo> @3 $(Protobuf.Group(true))
+}
+`},
+		)
+		require.Equal(t, []string{
+			"/a.mglot:4:4 -- M0033: field a is a group but its type isn't a struct",
+			"/a.mglot:5:4 -- M0033: field b is a group but its type isn't a struct",
+		}, reported)
+	})
+}
+
+func TestCompileFieldTypes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("protobuf", func(t *testing.T) {
+		t.Parallel()
+		const source = `syntax = "proto3";
+package a;
+message Foo {
+  sint32 a = 1;
+  sint64 b = 2;
+  fixed32 c = 3;
+  fixed64 d = 4;
+  sfixed32 e = 5;
+  sfixed64 f = 6;
+  repeated sint32 g = 7;
+  optional fixed64 h = 8;
+  int32 i = 9;
+}
+`
+		r := exc.NewReporter(nil)
+		c, err := New(OptionWithExcReporter(r), OptionWithFS(newTestFS(
+			CompilerTestFile{kind: idl.FileKindProtobuf, uri: "/a.proto", contents: source},
+		)))
+		require.NoError(t, err)
+		resp, err := c.Compile(context.Background(), &idl.CompileRequest{Files: []string{"/a.proto"}})
+		require.NoError(t, err, r.Reported())
+		set, err := resp.Image.ToFileDescriptorSet()
+		require.NoError(t, err)
+
+		expected, err := (&protocompile.Compiler{
+			Resolver: &protocompile.SourceResolver{
+				Accessor: protocompile.SourceAccessorFromMap(map[string]string{"a.proto": source}),
+			},
+		}).Compile(context.Background(), "a.proto")
+		require.NoError(t, err)
+		want := protodesc.ToFileDescriptorProto(expected[0])
+		var got *descriptorpb.FileDescriptorProto
+		for _, file := range set.File {
+			if file.GetName() == "a.proto" {
+				got = file
+			}
+		}
+		require.NotNil(t, got)
+		require.Len(t, got.MessageType, 1)
+		require.True(t, pb.Equal(want.MessageType[0], got.MessageType[0]), "want %v, got %v", want.MessageType[0], got.MessageType[0])
+	})
+
+	t.Run("microglot", func(t *testing.T) {
+		t.Parallel()
+		r := exc.NewReporter(nil)
+		c, err := New(OptionWithExcReporter(r), OptionWithFS(newTestFS(
+			CompilerTestFile{kind: idl.FileKindMicroglot, uri: "/a.mglot", contents: `syntax = "mglot0"
+module = @11
+struct Foo {
+    a :Int16 @1 $(Protobuf.FieldType("sint32"))
+    b :List<:UInt64> @2 $(Protobuf.FieldType("fixed64"))
+}
+`},
+		)))
+		require.NoError(t, err)
+		resp, err := c.Compile(context.Background(), &idl.CompileRequest{Files: []string{"/a.mglot"}})
+		require.NoError(t, err, r.Reported())
+		set, err := resp.Image.ToFileDescriptorSet()
+		require.NoError(t, err)
+		var got *descriptorpb.FileDescriptorProto
+		for _, file := range set.File {
+			if file.GetName() == "a.mglot" {
+				got = file
+			}
+		}
+		require.NotNil(t, got)
+		fields := got.MessageType[0].Field
+		require.Equal(t, descriptorpb.FieldDescriptorProto_TYPE_SINT32, fields[0].GetType())
+		require.Equal(t, descriptorpb.FieldDescriptorProto_TYPE_FIXED64, fields[1].GetType())
+		require.Equal(t, descriptorpb.FieldDescriptorProto_LABEL_REPEATED, fields[1].GetLabel())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+		for annotations, expected := range map[string]string{
+			`$(Protobuf.Syntax("proto4"))`:                           "/a.mglot:2:15 -- M0031: unknown protobuf syntax \"proto4\"",
+			`$(Protobuf.Syntax("editions"))`:                         "/a.mglot:2:15 -- M0031: module /a.mglot has the syntax editions but doesn't name its edition",
+			`$(Protobuf.Syntax("proto2"), Protobuf.Edition("2023"))`: "/a.mglot:2:42 -- M0031: module /a.mglot names the edition 2023 but has the syntax proto2",
+			`$(Protobuf.Edition("1999"))`:                            "/a.mglot:2:15 -- M0031: unknown protobuf edition \"1999\"",
+		} {
+			reported := compileErrors(t, CompilerTestFile{kind: idl.FileKindMicroglot, uri: "/a.mglot", contents: "syntax = \"mglot0\"\nmodule = @11 " + annotations + "\n"})
+			require.Equal(t, []string{expected}, reported)
+		}
+	})
+}
+
+func TestCompileStandardOptions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("protobuf", func(t *testing.T) {
+		t.Parallel()
+		const source = `syntax = "proto3";
+package a;
+option java_package = "org.example.a";
+option java_multiple_files = true;
+option optimize_for = CODE_SIZE;
+message Foo {
+  option deprecated = true;
+  repeated int32 a = 1 [packed = false];
+  string b = 2 [ctype = CORD, targets = TARGET_TYPE_FIELD, targets = TARGET_TYPE_MESSAGE];
+}
+enum Bar {
+  option deprecated = true;
+  BAR_ZERO = 0;
+  BAR_ONE = 1 [deprecated = true];
+}
+service Baz {
+  option deprecated = true;
+  rpc Get(Foo) returns (Foo) {
+    option idempotency_level = NO_SIDE_EFFECTS;
+  }
+}
+`
+		r := exc.NewReporter(nil)
+		c, err := New(OptionWithExcReporter(r), OptionWithFS(newTestFS(
+			CompilerTestFile{kind: idl.FileKindProtobuf, uri: "/a.proto", contents: source},
+		)))
+		require.NoError(t, err)
+		resp, err := c.Compile(context.Background(), &idl.CompileRequest{Files: []string{"/a.proto"}})
+		require.NoError(t, err, r.Reported())
+		set, err := resp.Image.ToFileDescriptorSet()
+		require.NoError(t, err)
+
+		expected, err := (&protocompile.Compiler{
+			Resolver: &protocompile.SourceResolver{
+				Accessor: protocompile.SourceAccessorFromMap(map[string]string{"a.proto": source}),
+			},
+		}).Compile(context.Background(), "a.proto")
+		require.NoError(t, err)
+		want := protodesc.ToFileDescriptorProto(expected[0])
+		var got *descriptorpb.FileDescriptorProto
+		for _, file := range set.File {
+			if file.GetName() == "a.proto" {
+				got = file
+			}
+		}
+		require.NotNil(t, got)
+		require.True(t, pb.Equal(want.Options, got.Options), "want %v, got %v", want.Options, got.Options)
+		require.True(t, pb.Equal(want.MessageType[0], got.MessageType[0]), "want %v, got %v", want.MessageType[0], got.MessageType[0])
+		require.True(t, pb.Equal(want.EnumType[0], got.EnumType[0]), "want %v, got %v", want.EnumType[0], got.EnumType[0])
+		require.True(t, pb.Equal(want.Service[0], got.Service[0]), "want %v, got %v", want.Service[0], got.Service[0])
+	})
+
+	t.Run("microglot", func(t *testing.T) {
+		t.Parallel()
+		r := exc.NewReporter(nil)
+		c, err := New(OptionWithExcReporter(r), OptionWithFS(newTestFS(
+			CompilerTestFile{kind: idl.FileKindMicroglot, uri: "/a.mglot", contents: `syntax = "mglot0"
+module = @11 $(Protobuf.FileOptionsOptimizeFor("LITE_RUNTIME"))
+struct M {
+    a :List<:Int32> @1 $(Protobuf.FieldOptionsPacked(false))
+}
+api S {
+    Get(:M) returns (:M) $(Protobuf.MethodOptionsDeprecated(true))
+} $(Protobuf.ServiceOptionsDeprecated(true))
+`},
+		)))
+		require.NoError(t, err)
+		resp, err := c.Compile(context.Background(), &idl.CompileRequest{Files: []string{"/a.mglot"}})
+		require.NoError(t, err, r.Reported())
+		set, err := resp.Image.ToFileDescriptorSet()
+		require.NoError(t, err)
+		var got *descriptorpb.FileDescriptorProto
+		for _, file := range set.File {
+			if file.GetName() == "a.mglot" {
+				got = file
+			}
+		}
+		require.NotNil(t, got)
+		require.Equal(t, descriptorpb.FileOptions_LITE_RUNTIME, got.GetOptions().GetOptimizeFor())
+		require.NotNil(t, got.MessageType[0].Field[0].GetOptions().Packed)
+		require.False(t, got.MessageType[0].Field[0].GetOptions().GetPacked())
+		require.True(t, got.Service[0].GetOptions().GetDeprecated())
+		require.True(t, got.Service[0].Method[0].GetOptions().GetDeprecated())
+		require.Nil(t, got.MessageType[0].Options)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+		reported := compileErrors(t,
+			CompilerTestFile{kind: idl.FileKindMicroglot, uri: "/a.mglot", contents: `syntax = "mglot0"
+module = @11 $(Protobuf.FileOptionsOptimizeFor("FAST"))
+struct M {
+    a :Text @1 $(Protobuf.FieldOptionsTargets(["TARGET_TYPE_FIELD", "TARGET_TYPE_NOTHING"]))
+}
+`},
+		)
+		require.Equal(t, []string{
+			"/a.mglot:2:15 -- M0031: FileOptionsOptimizeFor: FAST isn't a value of google.protobuf.FileOptions.OptimizeMode",
+			"/a.mglot:4:17 -- M0031: FieldOptionsTargets: TARGET_TYPE_NOTHING isn't a value of google.protobuf.FieldOptions.OptionTargetType",
+		}, reported)
+	})
+}
+
+func TestCompilePublicAndWeakImports(t *testing.T) {
+	t.Parallel()
+
+	sources := map[string]string{
+		"a.proto": `syntax = "proto3";
+package a;
+import public "b.proto";
+import weak "c.proto";
+message A {
+  b.B b = 1;
+  c.C c = 2;
+}
+`,
+		"b.proto": `syntax = "proto3";
+package b;
+import public "d.proto";
+message B {}
+`,
+		"c.proto": `syntax = "proto3";
+package c;
+message C {}
+`,
+		"d.proto": `syntax = "proto3";
+package d;
+message D {}
+`,
+	}
+	files := []CompilerTestFile{
+		{kind: idl.FileKindMicroglot, uri: "/m.mglot", contents: `syntax = "mglot0"
+module = @11
+import "/a.proto" as a
+struct M {
+    b :a.B @1
+    d :a.D @2
+}
+`},
+	}
+	for name, source := range sources {
+		files = append(files, CompilerTestFile{kind: idl.FileKindProtobuf, uri: "/" + name, contents: source})
+	}
+	r := exc.NewReporter(nil)
+	c, err := New(OptionWithExcReporter(r), OptionWithFS(newTestFS(files...)))
+	require.NoError(t, err)
+	resp, err := c.Compile(context.Background(), &idl.CompileRequest{Files: []string{"/m.mglot", "/a.proto"}})
+	require.NoError(t, err, r.Reported())
+
+	for _, module := range resp.Image.Modules {
+		switch module.URI {
+		case "/a.proto":
+			require.Len(t, module.Imports, 2)
+			require.True(t, module.Imports[0].IsPublic)
+			require.False(t, module.Imports[0].IsWeak)
+			require.False(t, module.Imports[1].IsPublic)
+			require.True(t, module.Imports[1].IsWeak)
+			var names []string
+			for _, dotImport := range module.DotImports {
+				names = append(names, dotImport.Name)
+			}
+			require.Equal(t, []string{"B", "D"}, names)
+		case "/m.mglot":
+			require.Empty(t, module.DotImports)
+		}
+	}
+
+	set, err := resp.Image.ToFileDescriptorSet()
+	require.NoError(t, err)
+	expected, err := (&protocompile.Compiler{
+		Resolver: &protocompile.SourceResolver{
+			Accessor: protocompile.SourceAccessorFromMap(sources),
+		},
+	}).Compile(context.Background(), "a.proto")
+	require.NoError(t, err)
+	want := protodesc.ToFileDescriptorProto(expected[0])
+	var got *descriptorpb.FileDescriptorProto
+	for _, file := range set.File {
+		if file.GetName() == "a.proto" {
+			got = file
+		}
+	}
+	require.NotNil(t, got)
+	require.Equal(t, want.Dependency, got.Dependency)
+	require.Equal(t, want.PublicDependency, got.PublicDependency)
+	require.Equal(t, want.WeakDependency, got.WeakDependency)
+}
+
+func TestCompileProtobufValidation(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		source   string
+		expected []string
+	}{
+		{
+			name:   "json name conflict",
+			source: "syntax = \"proto3\";\nmessage M {\n  int32 foo_bar = 1;\n  int32 fooBar = 2;\n}\n",
+			expected: []string{
+				"/a.proto:4:3 -- M0034: field M.fooBar: default JSON name \"fooBar\" conflicts with default JSON name of field foo_bar, defined at a.proto:3:3",
+			},
+		},
+		{
+			name:   "enum value scope",
+			source: "syntax = \"proto3\";\nenum A {\n  X = 0;\n}\nenum B {\n  X = 0;\n}\n",
+			expected: []string{
+				"/a.proto:6:3 -- M0034: symbol \"X\" already defined at a.proto:3:3; protobuf uses C++ scoping rules for enum values, so they exist in the scope enclosing the enum",
+			},
+		},
+		{
+			name:   "service as field type",
+			source: "syntax = \"proto3\";\nmessage M {\n  S s = 1;\n}\nservice S {}\n",
+			expected: []string{
+				"/a.proto:3:3 -- M0034: field M.s: invalid type: S is a service, not a message or enum",
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			reported := compileErrors(t,
+				CompilerTestFile{kind: idl.FileKindProtobuf, uri: "/a.proto", contents: testCase.source},
+			)
+			require.Equal(t, testCase.expected, reported)
+		})
+	}
+
+	t.Run("native import", func(t *testing.T) {
+		t.Parallel()
+		r := exc.NewReporter(nil)
+		c, err := New(OptionWithExcReporter(r), OptionWithFS(newTestFS(
+			CompilerTestFile{kind: idl.FileKindMicroglot, uri: "/m.mglot", contents: "syntax = \"mglot0\"\nmodule = @11 $(Protobuf.Package(\"m\"))\nstruct N {\n    a :Int32 @1\n}\n"},
+			CompilerTestFile{kind: idl.FileKindProtobuf, uri: "/a.proto", contents: "syntax = \"proto3\";\nimport \"m.mglot\";\nmessage M {\n  m.N n = 1;\n}\n"},
+		)))
+		require.NoError(t, err)
+		_, err = c.Compile(context.Background(), &idl.CompileRequest{Files: []string{"/a.proto"}})
+		require.NoError(t, err, r.Reported())
+	})
+}
+
+func TestCompileEditions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("protobuf", func(t *testing.T) {
+		t.Parallel()
+		const source = `edition = "2023";
+package a;
+option features.enum_type = CLOSED;
+message Foo {
+  int32 a = 1;
+  int32 b = 2 [features.field_presence = IMPLICIT];
+  int32 c = 3 [features.field_presence = LEGACY_REQUIRED];
+  repeated int32 d = 4;
+  repeated int32 e = 5 [features.repeated_field_encoding = EXPANDED];
+  Bar f = 6;
+  Foo g = 7 [features.message_encoding = DELIMITED];
+  oneof h {
+    int32 i = 8;
+  }
+  repeated Bar j = 9;
+  repeated Foo k = 10;
+  map<string, int32> l = 11;
+}
+enum Bar {
+  BAR_ZERO = 0;
+}
+enum Baz {
+  option features.enum_type = OPEN;
+  BAZ_ZERO = 0;
+}
+`
+		r := exc.NewReporter(nil)
+		c, err := New(OptionWithExcReporter(r), OptionWithFS(newTestFS(
+			CompilerTestFile{kind: idl.FileKindProtobuf, uri: "/a.proto", contents: source},
+		)))
+		require.NoError(t, err)
+		resp, err := c.Compile(context.Background(), &idl.CompileRequest{Files: []string{"/a.proto"}})
+		require.NoError(t, err, r.Reported())
+
+		// The features are resolved into the microglot equivalents.
+		var module *proto.Module
+		for _, m := range resp.Image.Modules {
+			if m.URI == "/a.proto" {
+				module = m
+			}
+		}
+		require.NotNil(t, module)
+		require.Equal(t, "editions", idl.GetProtobufAnnotation(module.AnnotationApplications, "Syntax").GetText().GetValue())
+		require.Equal(t, "2023", idl.GetProtobufAnnotation(module.AnnotationApplications, "Edition").GetText().GetValue())
+		typeName := func(field *proto.Field) string {
+			_, declaration := resp.Image.Lookup(field.Type.GetResolved().Reference)
+			return declaration.(*proto.Struct).Name.Name
+		}
+		var fields []*proto.Field
+		for _, struct_ := range module.Structs {
+			if struct_.Name.Name == "Foo" {
+				fields = struct_.Fields
+			}
+		}
+		require.Equal(t, "Presence", typeName(fields[0]))
+		require.Equal(t, "Int32", typeName(fields[1]))
+		require.Equal(t, "Int32", typeName(fields[2]))
+		require.True(t, idl.GetProtobufAnnotation(fields[2].AnnotationApplications, "Required").GetBool().GetValue())
+		require.True(t, idl.GetProtobufAnnotation(fields[3].AnnotationApplications, "Packed").GetBool().GetValue())
+		require.False(t, idl.GetProtobufAnnotation(fields[4].AnnotationApplications, "Packed").GetBool().GetValue())
+		require.Equal(t, "Presence", typeName(fields[5]))
+		require.Equal(t, "Int32", typeName(fields[7]))
+		require.True(t, idl.GetProtobufAnnotation(fields[8].AnnotationApplications, "Packed").GetBool().GetValue())
+		require.Nil(t, idl.GetProtobufAnnotation(fields[9].AnnotationApplications, "Packed"))
+		require.Nil(t, idl.GetProtobufAnnotation(fields[10].AnnotationApplications, "Packed"))
+		require.True(t, idl.GetProtobufAnnotation(module.Enums[0].AnnotationApplications, "ClosedEnum").GetBool().GetValue())
+		require.Nil(t, idl.GetProtobufAnnotation(module.Enums[1].AnnotationApplications, "ClosedEnum"))
+
+		// The file must come out as protoc produces it.
+		set, err := resp.Image.ToFileDescriptorSet()
+		require.NoError(t, err)
+		_, err = protodesc.NewFiles(set)
+		require.NoError(t, err)
+		expected, err := (&protocompile.Compiler{
+			Resolver: &protocompile.SourceResolver{
+				Accessor: protocompile.SourceAccessorFromMap(map[string]string{"a.proto": source}),
+			},
+		}).Compile(context.Background(), "a.proto")
+		require.NoError(t, err)
+		want := protodesc.ToFileDescriptorProto(expected[0])
+		var got *descriptorpb.FileDescriptorProto
+		for _, file := range set.File {
+			if file.GetName() == "a.proto" {
+				got = file
+			}
+		}
+		require.NotNil(t, got)
+		require.Equal(t, "editions", got.GetSyntax())
+		require.Equal(t, descriptorpb.Edition_EDITION_2023, got.GetEdition())
+		require.True(t, pb.Equal(want.Options, got.Options), "want %v, got %v", want.Options, got.Options)
+		require.True(t, pb.Equal(want.MessageType[0], got.MessageType[0]), "want %v, got %v", want.MessageType[0], got.MessageType[0])
+		require.True(t, pb.Equal(want.EnumType[0], got.EnumType[0]), "want %v, got %v", want.EnumType[0], got.EnumType[0])
+		require.True(t, pb.Equal(want.EnumType[1], got.EnumType[1]), "want %v, got %v", want.EnumType[1], got.EnumType[1])
+	})
+
+	t.Run("unsupported edition", func(t *testing.T) {
+		t.Parallel()
+		reported := compileErrors(t, CompilerTestFile{kind: idl.FileKindProtobuf, uri: "/a.proto", contents: "edition = \"1999\";\nmessage M {}\n"})
+		require.Equal(t, []string{
+			"/a.proto:1:11 -- M0006: /a.proto:1:11: edition value \"1999\" not recognized; should be one of [\"2023\"]",
+		}, reported)
+	})
+}
+
+func TestCompileProtobufSyntax(t *testing.T) {
+	t.Parallel()
+
+	compile := func(t *testing.T, source string, opts ...Option) *descriptorpb.FileDescriptorProto {
+		r := exc.NewReporter(nil)
+		c, err := New(append([]Option{OptionWithExcReporter(r), OptionWithFS(newTestFS(
+			CompilerTestFile{kind: idl.FileKindMicroglot, uri: "/a.mglot", contents: source},
+		))}, opts...)...)
+		require.NoError(t, err)
+		resp, err := c.Compile(context.Background(), &idl.CompileRequest{Files: []string{"/a.mglot"}})
+		require.NoError(t, err, r.Reported())
+		set, err := resp.Image.ToFileDescriptorSet()
+		require.NoError(t, err)
+		_, err = protodesc.NewFiles(set)
+		require.NoError(t, err)
+		for _, file := range set.File {
+			if file.GetName() == "a.mglot" {
+				return file
+			}
+		}
+		require.FailNow(t, "a.mglot wasn't converted")
+		return nil
+	}
+
+	t.Run("proto2 defaults", func(t *testing.T) {
+		t.Parallel()
+		got := compile(t, `syntax = "mglot0"
+module = @11 $(Protobuf.Syntax("proto2"))
+struct Foo {
+    a :Int32 = 7 @1
+    b :Bar = Bar.Two @2
+    c :Data = 0x"a'b" @3
+    d :Float64 = 1.5 @4
+    e :Bool = true @5
+    f :Text = "hi" @6
+}
+enum Bar {
+    One @0
+    Two @1
+}
+`)
+		require.Nil(t, got.Syntax)
+		var defaults []string
+		for _, field := range got.MessageType[0].Field {
+			defaults = append(defaults, field.GetDefaultValue())
+		}
+		require.Equal(t, []string{"7", "Bar_Two", `a\'b`, "1.5", "true", "hi"}, defaults)
+	})
+
+	t.Run("edition", func(t *testing.T) {
+		t.Parallel()
+		got := compile(t, `syntax = "mglot0"
+module = @11 $(Protobuf.Edition("2023"))
+struct Foo {
+    a :Presence<:Int32> = 7 @1
+    b :Int32 @2
+    c :Bar @3
+    d :Baz @4
+    e :Int32 = 3 @5 $(Protobuf.Required(true))
+    f :Foo @6 $(Protobuf.Group(true))
+}
+enum Bar {
+    One @0
+} $(Protobuf.ClosedEnum(true))
+enum Baz {
+    Zero @0
+}
+`)
+		require.Equal(t, "editions", got.GetSyntax())
+		require.Equal(t, descriptorpb.Edition_EDITION_2023, got.GetEdition())
+		fields := got.MessageType[0].Field
+		require.Equal(t, "7", fields[0].GetDefaultValue())
+		require.Nil(t, fields[0].Options)
+		require.Equal(t, descriptorpb.FeatureSet_IMPLICIT, fields[1].GetOptions().GetFeatures().GetFieldPresence())
+		require.Nil(t, fields[2].Options)
+		require.Equal(t, descriptorpb.FeatureSet_IMPLICIT, fields[3].GetOptions().GetFeatures().GetFieldPresence())
+		require.Equal(t, descriptorpb.FeatureSet_LEGACY_REQUIRED, fields[4].GetOptions().GetFeatures().GetFieldPresence())
+		require.Equal(t, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, fields[5].GetType())
+		require.Equal(t, descriptorpb.FeatureSet_DELIMITED, fields[5].GetOptions().GetFeatures().GetMessageEncoding())
+		require.Equal(t, descriptorpb.FeatureSet_CLOSED, got.EnumType[0].GetOptions().GetFeatures().GetEnumType())
+		require.Nil(t, got.EnumType[1].Options)
+	})
+
+	t.Run("override", func(t *testing.T) {
+		t.Parallel()
+		const source = `syntax = "mglot0"
+module = @11 $(Protobuf.Syntax("proto3"))
+struct Foo {
+    a :Presence<:Int32> = 7 @1
+}
+`
+		got := compile(t, source, OptionWithProtobufSyntax("proto2", ""))
+		require.Nil(t, got.Syntax)
+		require.Equal(t, "7", got.MessageType[0].Field[0].GetDefaultValue())
+
+		got = compile(t, source, OptionWithProtobufSyntax("", "2023"))
+		require.Equal(t, "editions", got.GetSyntax())
+		require.Equal(t, "7", got.MessageType[0].Field[0].GetDefaultValue())
+
+		_, err := New(OptionWithProtobufSyntax("proto4", ""))
+		require.Error(t, err)
+		_, err = New(OptionWithProtobufSyntax("proto2", "2023"))
+		require.Error(t, err)
+	})
+
+	t.Run("proto3 optional", func(t *testing.T) {
+		t.Parallel()
+		got := compile(t, `syntax = "mglot0"
+module = @11 $(Protobuf.Syntax("proto3"))
+struct Foo {
+    a :Presence<:Int32> @1
+}
+`)
+		require.True(t, got.MessageType[0].Field[0].GetProto3Optional())
+		require.Equal(t, "_a", got.MessageType[0].OneofDecl[0].GetName())
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		t.Parallel()
+		reported := compileErrors(t, CompilerTestFile{kind: idl.FileKindMicroglot, uri: "/a.mglot", contents: `syntax = "mglot0"
+module = @11 $(Protobuf.Syntax("proto3"))
+struct Foo {
+    a :Int32 = 7 @1
+    b :Int32 @2 $(Protobuf.Required(true))
+    c :Foo @3 $(Protobuf.Group(true))
+    d :Bar @4
+}
+enum Bar {
+    One @0
+} $(Protobuf.ClosedEnum(true))
+`})
+		require.Equal(t, []string{
+			"/a.mglot:4:4 -- M0035: field a has a default value, which proto3 doesn't support",
+			"/a.mglot:5:4 -- M0035: field b is required, which proto3 doesn't support",
+			"/a.mglot:6:4 -- M0035: field c is a group, which proto3 doesn't support",
+			"/a.mglot:7:4 -- M0035: field d has a closed enum type, which proto3 doesn't support",
+			"/a.mglot:9:5 -- M0035: enum Bar is closed, which proto3 doesn't support",
+		}, reported)
+
+		reported = compileErrors(t, CompilerTestFile{kind: idl.FileKindMicroglot, uri: "/a.mglot", contents: `syntax = "mglot0"
+module = @11 $(Protobuf.Edition("2023"))
+struct Foo {
+    a :Int32 = 7 @1
+    b :List<:Int32> = [1] @2
+}
+`})
+		require.Equal(t, []string{
+			"/a.mglot:4:4 -- M0035: field a has a default value but no presence, which editions require of fields with defaults",
+			"/a.mglot:5:4 -- M0035: field b has a default value, which editions doesn't support for repeated fields",
+		}, reported)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+		for _, annotations := range []string{`$(Protobuf.Syntax("proto4"))`, `$(Protobuf.Syntax("editions"))`, `$(Protobuf.Syntax("proto2"), Protobuf.Edition("2023"))`, `$(Protobuf.Edition("1999"))`} {
+			reported := compileErrors(t, CompilerTestFile{kind: idl.FileKindMicroglot, uri: "/a.mglot", contents: "syntax = \"mglot0\"\nmodule = @11 " + annotations + "\n"})
+			require.Len(t, reported, 1)
+			t.Log(reported[0])
+		}
+	})
+}
+
+func TestCompileVirtualTypes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("map values", func(t *testing.T) {
+		t.Parallel()
+		r := exc.NewReporter(nil)
+		c, err := New(OptionWithExcReporter(r), OptionWithFS(newTestFS(
+			CompilerTestFile{kind: idl.FileKindMicroglot, uri: "/lib.mglot", contents: `syntax = "mglot0"
+module = @13
+enum Color {
+    Red @0
+    Blue @1
+}
+const Answer :Int32 = 42
+struct Settings {
+    Colors :Map<:Text, :Color> @1
+    Limits :Map<:Int32, :Text> @2
+}
+annotation Configure(struct) :Settings @5000
+struct Foo {
+    Counts :Map<:Text, :Int32> = ["a": 1] @1
+} $(Configure({Colors: ["sky": Color.Blue], Limits: [Answer: "answer", 1: "one"]}))
+`},
+		)))
+		require.NoError(t, err)
+		resp, err := c.Compile(context.Background(), &idl.CompileRequest{Files: []string{"/lib.mglot"}})
+		require.NoError(t, err, r.Reported())
+		set, err := resp.Image.ToFileDescriptorSet()
+		require.NoError(t, err)
+		files, err := protodesc.NewFiles(set)
+		require.NoError(t, err)
+
+		descriptor, err := files.FindDescriptorByName("lib.Configure")
+		require.NoError(t, err)
+		configure := dynamicpb.NewExtensionType(descriptor.(protoreflect.ExtensionDescriptor))
+		types := new(protoregistry.Types)
+		require.NoError(t, types.RegisterExtension(configure))
+		var foo *descriptorpb.DescriptorProto
+		for _, file := range set.File {
+			for _, messageType := range file.MessageType {
+				if file.GetName() == "lib.mglot" && messageType.GetName() == "Foo" {
+					foo = messageType
+				}
+			}
+		}
+		require.NotNil(t, foo)
+		b, err := pb.Marshal(foo.Options)
+		require.NoError(t, err)
+		options := new(descriptorpb.MessageOptions)
+		require.NoError(t, pb.UnmarshalOptions{Resolver: types}.Unmarshal(b, options))
+		settings := options.ProtoReflect().Get(configure.TypeDescriptor()).Message()
+		colors := settings.Get(settings.Descriptor().Fields().ByName("Colors")).Map()
+		require.Equal(t, 1, colors.Len())
+		require.Equal(t, protoreflect.EnumNumber(1), colors.Get(protoreflect.ValueOfString("sky").MapKey()).Enum())
+		limits := settings.Get(settings.Descriptor().Fields().ByName("Limits")).Map()
+		require.Equal(t, 2, limits.Len())
+		require.Equal(t, "answer", limits.Get(protoreflect.ValueOfInt32(42).MapKey()).String())
+		require.Equal(t, "one", limits.Get(protoreflect.ValueOfInt32(1).MapKey()).String())
+	})
+
+	t.Run("constraints", func(t *testing.T) {
+		t.Parallel()
+		reported := compileErrors(t, CompilerTestFile{kind: idl.FileKindMicroglot, uri: "/a.mglot", contents: `syntax = "mglot0"
+module = @11
+struct Foo {
+    a :List<:Map<:Text, :Text>> @1
+    b :Map<:Data, :Text> @2
+    c :Map<:Float32, :Text> @3
+    d :Map<:Text, :List<:Text>> @4
+    e :Presence<:Presence<:Text>> @5
+    f :Map<:Text, :Presence<:Text>> @6
+}
+`})
+		require.Equal(t, []string{
+			"/a.mglot:4:13 -- M0036: List can't hold a Map",
+			"/a.mglot:5:12 -- M0037: Map keys can't be Data (expecting Bool, Text, or an integer type)",
+			"/a.mglot:6:12 -- M0037: Map keys can't be Float32 (expecting Bool, Text, or an integer type)",
+			"/a.mglot:7:19 -- M0038: Map values can't be List",
+			"/a.mglot:8:17 -- M0039: Presence can't hold Presence (expecting a scalar, enum, or struct type)",
+		}, reported)
+	})
+
+	t.Run("duplicate map keys", func(t *testing.T) {
+		t.Parallel()
+		reported := compileErrors(t, CompilerTestFile{kind: idl.FileKindMicroglot, uri: "/a.mglot", contents: `syntax = "mglot0"
+module = @11
+const Answer :Int32 = 42
+struct Foo {
+    a :Map<:Text, :Int32> = ["a": 1, "b": 2, "a": 3] @1
+    b :Map<:Int32, :Text> = [1: "one", 42: "answer", Answer: "answer"] @2
+}
+`})
+		require.Equal(t, []string{
+			"/a.mglot:5:47 -- M0037: map literal has the key \"a\" more than once",
+			"/a.mglot:6:53 -- M0037: map literal has the key 42 more than once",
+		}, reported)
+	})
+}
@@ -0,0 +1,75 @@
+// © 2023 Microglot LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"gopkg.microglot.org/mglotc/internal/exc"
+	"gopkg.microglot.org/mglotc/internal/proto"
+	"gopkg.microglot.org/mglotc/internal/target"
+)
+
+// checkImportCycles reports the import cycles among the given modules, keyed
+// by URI. Each cycle is reported at the import that closes it, and the message
+// includes the full chain of imports, e.g. "/a.mglot -> /b.proto -> /a.mglot".
+// Imports of modules that aren't present are ignored. An error is returned if
+// any cycle was found.
+//
+// The modules are visited in URI order, and their imports in declaration
+// order, so the same cycles are reported in the same way on every run.
+func checkImportCycles(modules map[string]*proto.Module, r exc.Reporter) error {
+	uris := make([]string, 0, len(modules))
+	for uri := range modules {
+		uris = append(uris, uri)
+	}
+	sort.Strings(uris)
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(modules))
+	var stack []string
+	var found bool
+	var visit func(uri string)
+	visit = func(uri string) {
+		state[uri] = visiting
+		stack = append(stack, uri)
+		for _, import_ := range modules[uri].Imports {
+			importedURI := target.Normalize(import_.ImportedURI)
+			if _, ok := modules[importedURI]; !ok {
+				continue
+			}
+			switch state[importedURI] {
+			case unvisited:
+				visit(importedURI)
+			case visiting:
+				start := len(stack) - 1
+				for stack[start] != importedURI {
+					start = start - 1
+				}
+				found = true
+				chain := append(append([]string{}, stack[start:]...), importedURI)
+				_ = r.Report(exc.New(sourceLocation(uri, import_.Location), exc.CodeImportCycle, fmt.Sprintf("import cycle: %s", strings.Join(chain, " -> "))))
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[uri] = visited
+	}
+	for _, uri := range uris {
+		if state[uri] == unvisited {
+			visit(uri)
+		}
+	}
+	if found {
+		return errors.New("import cycle")
+	}
+	return nil
+}
@@ -0,0 +1,178 @@
+// © 2023 Microglot LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.microglot.org/mglotc/internal/exc"
+	"gopkg.microglot.org/mglotc/internal/idl"
+	"gopkg.microglot.org/mglotc/internal/proto"
+)
+
+// extendable is an API or SDK, which may extend other declarations of the same
+// kind and so inherit their methods.
+type extendable struct {
+	kind     string
+	name     string
+	module   *proto.Module
+	location *proto.SourceLocation
+	extends  []*proto.TypeSpecifier
+	methods  []extendableMethod
+}
+
+type extendableMethod struct {
+	name     string
+	location *proto.SourceLocation
+}
+
+// describe names the declaration, qualified by its module if that isn't the
+// module being checked.
+func (e *extendable) describe(module *proto.Module) string {
+	if e.module == module {
+		return e.name
+	}
+	return fmt.Sprintf("%s (%s)", e.name, e.module.URI)
+}
+
+// extendsGraph indexes every API and SDK of an image so that their extends
+// lists can be followed across modules.
+type extendsGraph struct {
+	apis map[string]*extendable
+	sdks map[string]*extendable
+}
+
+func extendableKey(reference *proto.TypeReference) string {
+	return fmt.Sprintf("%d.%d", reference.ModuleUID, reference.TypeUID)
+}
+
+func newExtendsGraph(image *idl.Image) *extendsGraph {
+	graph := &extendsGraph{
+		apis: make(map[string]*extendable),
+		sdks: make(map[string]*extendable),
+	}
+	for _, module := range image.Modules {
+		for _, api := range module.APIs {
+			e := &extendable{kind: "API", name: api.Name.Name, module: module, location: api.Location, extends: api.Extends}
+			for _, apiMethod := range api.Methods {
+				e.methods = append(e.methods, extendableMethod{apiMethod.Name, apiMethod.Location})
+			}
+			graph.apis[extendableKey(api.Reference)] = e
+		}
+		for _, sdk := range module.SDKs {
+			e := &extendable{kind: "SDK", name: sdk.Name.Name, module: module, location: sdk.Location, extends: sdk.Extends}
+			for _, sdkMethod := range sdk.Methods {
+				e.methods = append(e.methods, extendableMethod{sdkMethod.Name, sdkMethod.Location})
+			}
+			graph.sdks[extendableKey(sdk.Reference)] = e
+		}
+	}
+	return graph
+}
+
+// extended returns the declaration that the given extends entry refers to, or
+// nil if it doesn't refer to one of the same kind. Such entries are reported
+// by checkTypeSpecifier.
+func (g *extendsGraph) extended(e *extendable, ts *proto.TypeSpecifier) *extendable {
+	resolved, ok := ts.Reference.(*proto.TypeSpecifier_Resolved)
+	if !ok {
+		return nil
+	}
+	if e.kind == "API" {
+		return g.apis[extendableKey(resolved.Resolved.Reference)]
+	}
+	return g.sdks[extendableKey(resolved.Resolved.Reference)]
+}
+
+// checkExtends reports an API or SDK that transitively extends itself, and
+// methods with the same name that it declares or inherits from different
+// declarations. The same method inherited along more than one path is not a
+// collision.
+func (c *imageChecker) checkExtends(e *extendable) {
+	for _, ts := range e.extends {
+		if path := c.extends.cycle(e, ts); path != nil {
+			names := make([]string, 0, len(path)+1)
+			names = append(names, e.describe(c.module))
+			for _, step := range path {
+				names = append(names, step.describe(c.module))
+			}
+			c.reporter.Report(exc.New(c.location(ts.Location), exc.CodeExtendsCycle, fmt.Sprintf("%s %s extends itself: %s", e.kind, e.name, strings.Join(names, " -> "))))
+		}
+	}
+
+	origins := make(map[string]*extendable, len(e.methods))
+	for _, method := range e.methods {
+		origins[method.name] = e
+	}
+	visited := map[*extendable]bool{e: true}
+	for _, ts := range e.extends {
+		var visit func(current *extendable)
+		visit = func(current *extendable) {
+			if visited[current] {
+				return
+			}
+			visited[current] = true
+			for _, method := range current.methods {
+				origin, ok := origins[method.name]
+				switch {
+				case !ok:
+					origins[method.name] = current
+				case origin == e:
+					c.reporter.Report(exc.New(c.location(c.methodLocation(e, method.name)), exc.CodeMethodCollision, fmt.Sprintf("method %s of %s %s is declared by both %s and %s", method.name, e.kind, e.name, origin.describe(c.module), current.describe(c.module))))
+				case origin != current:
+					c.reporter.Report(exc.New(c.location(ts.Location), exc.CodeMethodCollision, fmt.Sprintf("method %s of %s %s is declared by both %s and %s", method.name, e.kind, e.name, origin.describe(c.module), current.describe(c.module))))
+				}
+			}
+			for _, next := range current.extends {
+				if extended := c.extends.extended(current, next); extended != nil {
+					visit(extended)
+				}
+			}
+		}
+		if extended := c.extends.extended(e, ts); extended != nil {
+			visit(extended)
+		}
+	}
+}
+
+func (c *imageChecker) methodLocation(e *extendable, name string) *proto.SourceLocation {
+	for _, method := range e.methods {
+		if method.name == name {
+			return method.location
+		}
+	}
+	return e.location
+}
+
+// cycle returns the path from the given extends entry of a declaration back to
+// the declaration itself, or nil if there is none. Declarations are visited
+// in the order in which they are extended, so the same path is found on every
+// run.
+func (g *extendsGraph) cycle(e *extendable, ts *proto.TypeSpecifier) []*extendable {
+	visited := make(map[*extendable]bool)
+	var path []*extendable
+	var visit func(current *extendable) bool
+	visit = func(current *extendable) bool {
+		path = append(path, current)
+		if current == e {
+			return true
+		}
+		if !visited[current] {
+			visited[current] = true
+			for _, next := range current.extends {
+				if extended := g.extended(current, next); extended != nil && visit(extended) {
+					return true
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		return false
+	}
+	if extended := g.extended(e, ts); extended != nil && visit(extended) {
+		return path
+	}
+	return nil
+}
@@ -114,6 +114,10 @@ func (self *lexerFileIDLTokens) Next(ctx context.Context) optional.Optional[*idl
 				case "syntax":
 					t.Type = idl.TokenTypeKeywordSyntax
 					return optional.Some(t)
+				case "edition":
+					// edition is only a keyword of protobuf, so it stays an
+					// identifier rather than getting a token type of its own.
+					return optional.Some(t)
 				}
 			}
 		}
@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"gopkg.microglot.org/mglotc/internal/exc"
+	"gopkg.microglot.org/mglotc/internal/fs"
 	"gopkg.microglot.org/mglotc/internal/idl"
 	"gopkg.microglot.org/mglotc/internal/proto"
 )
@@ -22,17 +23,24 @@ func link(parsed proto.Module, gsymbols *globalSymbolTable, r exc.Reporter) (*pr
 		return nil, errors.New("unable to initialize local symbol table (???)")
 	}
 
-	// alias all of the dependencies' symbols into the local symbol table
+	// alias all of the dependencies' symbols into the local symbol table,
+	// along with the symbols that they re-export through public imports.
 	for _, import_ := range parsed.Imports {
 		if !symbols.alias(gsymbols, import_.ImportedURI, import_.Alias, import_.IsDot) {
-			_ = r.Report(exc.New(exc.Location{
-				URI: parsed.URI,
-				// TODO 2023.09.12: getting Location here would sure be nice!
-			}, exc.CodeUnknownImport, fmt.Sprintf("unknown import %s", import_.ImportedURI)))
+			_ = r.Report(exc.New(sourceLocation(parsed.URI, import_.Location), exc.CodeUnknownImport, fmt.Sprintf("unknown import %s", import_.ImportedURI)))
+			continue
+		}
+		for _, reexported := range gsymbols.reexported(import_.ImportedURI) {
+			symbols.alias(gsymbols, reexported, import_.Alias, import_.IsDot)
 		}
 	}
+	parsed.DotImports = gsymbols.dotImports(parsed.URI)
 
 	// populate all the TypeSpecifiers
+	// Protobuf has no constants, so the only identifiers in a protobuf module
+	// are the enum values in custom options. They are resolved against the
+	// types of the options by interpretOptions instead.
+	isProtobuf := fs.KindOf(parsed.URI) == idl.FileKindProtobuf
 	var promotedSymbolTable map[string]string
 	walkModule(&parsed, func(node interface{}) {
 		switch n := node.(type) {
@@ -41,6 +49,13 @@ func link(parsed proto.Module, gsymbols *globalSymbolTable, r exc.Reporter) (*pr
 			// type names to the "promoted" type names. This mapping is applied to all of the
 			// TypeSpecifiers inside the struct as we walk into them.
 			promotedSymbolTable = idl.GetPromotedSymbolTable(n.AnnotationApplications)
+		case *proto.Extension:
+			// The field of an extension belongs to the struct that it
+			// extends, which is only known once the extendee is resolved.
+			if resolved, ok := n.Extendee.Reference.(*proto.TypeSpecifier_Resolved); ok {
+				n.Field.Reference.ModuleUID = resolved.Resolved.Reference.ModuleUID
+				n.Field.Reference.TypeUID = resolved.Resolved.Reference.TypeUID
+			}
 		case *proto.TypeSpecifier:
 			switch kind := n.Reference.(type) {
 			case *proto.TypeSpecifier_Forward:
@@ -79,10 +94,7 @@ func link(parsed proto.Module, gsymbols *globalSymbolTable, r exc.Reporter) (*pr
 					}
 				}
 				if !ok {
-					_ = r.Report(exc.New(exc.Location{
-						URI: parsed.URI,
-						// TODO 2023.11.01: getting Location here would sure be nice!
-					}, exc.CodeUnknownType, fmt.Sprintf("unknown type %s", fullName)))
+					_ = r.Report(exc.New(sourceLocation(parsed.URI, n.Location), exc.CodeUnknownType, fmt.Sprintf("unknown type %s", fullName)))
 				} else {
 					n.Reference = &proto.TypeSpecifier_Resolved{
 						Resolved: &proto.ResolvedReference{
@@ -92,43 +104,48 @@ func link(parsed proto.Module, gsymbols *globalSymbolTable, r exc.Reporter) (*pr
 					}
 				}
 			}
-		case *proto.ValueIdentifier:
+		case *proto.Value:
+			// Identifiers are resolved here, rather than when walking into the
+			// ValueIdentifier itself, so that the location of the enclosing
+			// value is available for reporting.
+			kind, ok := n.Kind.(*proto.Value_Identifier)
+			if !ok || isProtobuf {
+				return
+			}
+			identifier := kind.Identifier
 			// TODO 2023.09.23: the ambiguity of whether the first component of the ValueIdentifier
 			// is a qualifier or a type seems... off?
 			possibleSymbolNames := []localSymbolName{
 				localSymbolName{
 					qualifier: "",
-					name:      strings.Join(n.Names, "."),
+					name:      strings.Join(identifier.Names, "."),
 				},
 			}
-			if len(n.Names) > 1 {
+			if len(identifier.Names) > 1 {
 				possibleSymbolNames = append(possibleSymbolNames, localSymbolName{
-					qualifier: n.Names[0],
-					name:      strings.Join(n.Names[1:], "."),
+					qualifier: identifier.Names[0],
+					name:      strings.Join(identifier.Names[1:], "."),
 				})
 			}
 
 			for _, symbolName := range possibleSymbolNames {
 				type_, ok := symbols.types[symbolName]
 				if ok {
-					n.Reference = &proto.ValueIdentifier_Type{
+					identifier.Reference = &proto.ValueIdentifier_Type{
 						Type: &type_,
 					}
 					return
 				}
 				attribute, ok := symbols.attributes[symbolName]
 				if ok {
-					n.Reference = &proto.ValueIdentifier_Attribute{
+					identifier.Reference = &proto.ValueIdentifier_Attribute{
 						Attribute: &attribute,
 					}
 					return
 				}
 			}
 
-			_ = r.Report(exc.New(exc.Location{
-				URI: parsed.URI,
-				// TODO 2023.09.23: getting Location here would sure be nice!
-			}, exc.CodeUnknownIdentifier, fmt.Sprintf("unknown identifier: %s", strings.Join(n.Names, "."))))
+			_ = r.Report(exc.New(sourceLocation(parsed.URI, n.Location), exc.CodeUnknownIdentifier, fmt.Sprintf("unknown identifier: %s", strings.Join(identifier.Names, "."))))
 		}
 	})
 
@@ -0,0 +1,29 @@
+// © 2023 Microglot LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"gopkg.microglot.org/mglotc/internal/exc"
+	"gopkg.microglot.org/mglotc/internal/idl"
+	"gopkg.microglot.org/mglotc/internal/proto"
+)
+
+// sourceLocation converts a location recorded in a descriptor into one that
+// can be reported. Descriptors that weren't compiled from source, such as
+// those loaded from a descriptor set without SourceCodeInfo, have no location
+// and result in one that only identifies the module.
+func sourceLocation(uri string, location *proto.SourceLocation) exc.Location {
+	if location == nil {
+		return exc.Location{URI: uri}
+	}
+	return exc.Location{
+		URI: uri,
+		Location: idl.Location{
+			Line:   location.Line,
+			Column: location.Column,
+			Offset: location.Offset,
+		},
+	}
+}
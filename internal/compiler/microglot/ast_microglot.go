@@ -51,6 +51,12 @@ type structelement interface {
 	structelement()
 }
 
+// interface for all reserved element types
+type reservedelement interface {
+	node
+	reservedelement()
+}
+
 // interface for all implementation method types
 type implmethod interface {
 	node
@@ -124,6 +130,7 @@ type astStatementEnum struct {
 	astNode
 	identifier    idl.Token
 	innerComments *astCommentBlock
+	reserved      *astReserved
 	enumerants    []astEnumerant
 	meta          astMetadata
 }
@@ -132,6 +139,7 @@ type astStatementStruct struct {
 	astNode
 	typeName      astTypeName
 	innerComments *astCommentBlock
+	reserved      *astReserved
 	elements      []structelement
 	meta          astMetadata
 }
@@ -141,6 +149,7 @@ type astStatementAPI struct {
 	typeName      astTypeName
 	extends       *astExtension
 	innerComments *astCommentBlock
+	reserved      *astReserved
 	methods       []astAPIMethod
 	meta          astMetadata
 }
@@ -150,6 +159,7 @@ type astStatementSDK struct {
 	typeName      astTypeName
 	extends       *astExtension
 	innerComments *astCommentBlock
+	reserved      *astReserved
 	methods       []astSDKMethod
 	meta          astMetadata
 }
@@ -202,6 +212,17 @@ type astImplRequires struct {
 	requirements  []astImplRequirement
 }
 
+type astReserved struct {
+	astNode
+	elements []reservedelement
+}
+
+type astReservedRange struct {
+	astNode
+	start astValueLiteralInt
+	end   *astValueLiteralInt
+}
+
 type astImplAs struct {
 	astNode
 	types []astTypeSpecifier
@@ -239,11 +260,13 @@ type astExtension struct {
 
 type astAPIMethodInput struct {
 	astNode
+	stream        bool
 	typeSpecifier astTypeSpecifier
 }
 
 type astAPIMethodReturns struct {
 	astNode
+	stream        bool
 	typeSpecifier astTypeSpecifier
 }
 
@@ -369,6 +392,17 @@ type astValueLiteralList struct {
 	vals []astValue
 }
 
+type astValueLiteralMap struct {
+	astNode
+	vals []astLiteralMapPair
+}
+
+type astLiteralMapPair struct {
+	astNode
+	key   astValue
+	value astValue
+}
+
 type astValueLiteralStruct struct {
 	astNode
 	vals []astLiteralStructPair
@@ -519,6 +553,8 @@ func (astImplSDKMethod) node()       {}
 func (astImplAPIMethod) node()       {}
 func (astImplRequirement) node()     {}
 func (astImplRequires) node()        {}
+func (astReserved) node()            {}
+func (astReservedRange) node()       {}
 func (astSDKMethod) node()           {}
 func (astSDKMethodParameter) node()  {}
 func (astAPIMethod) node()           {}
@@ -533,9 +569,11 @@ func (astValueLiteralInt) node()     {}
 func (astValueLiteralFloat) node()   {}
 func (astValueLiteralData) node()    {}
 func (astValueLiteralList) node()    {}
+func (astValueLiteralMap) node()     {}
 func (astValueLiteralStruct) node()  {}
 func (astValueIdentifier) node()     {}
 func (astLiteralStructPair) node()   {}
+func (astLiteralMapPair) node()      {}
 func (astAnnotationScope) node()     {}
 func (astTypeSpecifier) node()       {}
 func (astTypeName) node()            {}
@@ -577,6 +615,7 @@ func (astValueLiteralFloat) value()  {}
 func (astValueLiteralText) value()   {}
 func (astValueLiteralData) value()   {}
 func (astValueLiteralList) value()   {}
+func (astValueLiteralMap) value()    {}
 func (astValueLiteralStruct) value() {}
 func (astValueIdentifier) value()    {}
 
@@ -590,6 +629,9 @@ func (astInvocation) valueorinvocation() {}
 func (astUnion) structelement() {}
 func (astField) structelement() {}
 
+func (astReservedRange) reservedelement()    {}
+func (astValueLiteralText) reservedelement() {}
+
 func (astImplSDKMethod) implmethod() {}
 func (astImplAPIMethod) implmethod() {}
 
@@ -122,6 +122,7 @@ func fromStatementImport(statementImport *astStatementImport) *proto.Import {
 		ImportedURI:  statementImport.uri.val.Value,
 		Alias:        statementImport.name.Value,
 		CommentBlock: fromCommentBlock(statementImport.comments),
+		Location:     fromTokenLocation(&statementImport.uri.val, statementImport.loc),
 	}
 }
 
@@ -132,6 +133,7 @@ func fromStatementAnnotation(statementAnnotation *astStatementAnnotation) *proto
 		Scopes:                 fromAnnotationScopes(statementAnnotation.annotationScopes),
 		Type:                   fromTypeSpecifier(&statementAnnotation.typeSpecifier),
 		DescriptorCommentBlock: fromCommentBlock(statementAnnotation.comments),
+		Location:               fromTokenLocation(&statementAnnotation.identifier, statementAnnotation.loc),
 	}
 }
 
@@ -143,19 +145,22 @@ func fromStatementConst(statementConst *astStatementConst) *proto.Constant {
 		Value:                  fromValue(&statementConst.value),
 		AnnotationApplications: fromAnnotationApplication(statementConst.meta.annotationApplication),
 		CommentBlock:           fromCommentBlock(statementConst.meta.comments),
+		Location:               fromTokenLocation(&statementConst.identifier, statementConst.loc),
 	}
 	return &x
 }
 
 func fromStatementEnum(statementEnum *astStatementEnum) *proto.Enum {
+	reserved, reservedNames := fromReserved(statementEnum.reserved)
 	result := &proto.Enum{
-		Reference:  fromTypeUID(statementEnum.meta.uid),
-		Name:       statementEnum.identifier.Value,
-		Enumerants: mapFrom(statementEnum.enumerants, fromEnumerant),
-		// Reserved:
-		// ReservedNames:
+		Reference:              fromTypeUID(statementEnum.meta.uid),
+		Name:                   statementEnum.identifier.Value,
+		Enumerants:             mapFrom(statementEnum.enumerants, fromEnumerant),
+		Reserved:               reserved,
+		ReservedNames:          reservedNames,
 		CommentBlock:           fromCommentBlock(statementEnum.meta.comments),
 		AnnotationApplications: fromAnnotationApplication(statementEnum.meta.annotationApplication),
+		Location:               fromTokenLocation(&statementEnum.identifier, statementEnum.loc),
 	}
 	var foundZero bool
 	for _, en := range result.Enumerants {
@@ -177,15 +182,18 @@ func fromStatementEnum(statementEnum *astStatementEnum) *proto.Enum {
 }
 
 func fromStatementStruct(statementStruct *astStatementStruct) *proto.Struct {
+	reserved, reservedNames := fromReserved(statementStruct.reserved)
 	this := proto.Struct{
-		Reference: fromTypeUID(statementStruct.meta.uid),
-		Name:      fromTypeName(&statementStruct.typeName),
-		Fields:    nil,
-		Unions:    nil,
-		// Reserved:
+		Reference:              fromTypeUID(statementStruct.meta.uid),
+		Name:                   fromTypeName(&statementStruct.typeName),
+		Fields:                 nil,
+		Unions:                 nil,
+		Reserved:               reserved,
+		ReservedNames:          reservedNames,
 		CommentBlock:           fromCommentBlock(statementStruct.meta.comments),
 		AnnotationApplications: fromAnnotationApplication(statementStruct.meta.annotationApplication),
 		// IsSynthetic:
+		Location: fromTokenLocation(&statementStruct.typeName.identifier, statementStruct.loc),
 	}
 
 	for _, element := range statementStruct.elements {
@@ -209,15 +217,17 @@ func fromStatementAPI(statementAPI *astStatementAPI) *proto.API {
 		extends = mapFrom(statementAPI.extends.extensions, fromTypeSpecifier)
 	}
 
+	reserved, reservedNames := fromReserved(statementAPI.reserved)
 	return &proto.API{
-		Reference: fromTypeUID(statementAPI.meta.uid),
-		Name:      fromTypeName(&statementAPI.typeName),
-		Methods:   mapFrom(statementAPI.methods, fromAPIMethod),
-		Extends:   extends,
-		// Reserved:
-		// ReservedNames:
+		Reference:              fromTypeUID(statementAPI.meta.uid),
+		Name:                   fromTypeName(&statementAPI.typeName),
+		Methods:                mapFrom(statementAPI.methods, fromAPIMethod),
+		Extends:                extends,
+		Reserved:               reserved,
+		ReservedNames:          reservedNames,
 		CommentBlock:           fromCommentBlock(statementAPI.meta.comments),
 		AnnotationApplications: fromAnnotationApplication(statementAPI.meta.annotationApplication),
+		Location:               fromTokenLocation(&statementAPI.typeName.identifier, statementAPI.loc),
 	}
 }
 
@@ -227,15 +237,17 @@ func fromStatementSDK(statementSDK *astStatementSDK) *proto.SDK {
 		extends = mapFrom(statementSDK.extends.extensions, fromTypeSpecifier)
 	}
 
+	reserved, reservedNames := fromReserved(statementSDK.reserved)
 	return &proto.SDK{
-		Reference: fromTypeUID(statementSDK.meta.uid),
-		Name:      fromTypeName(&statementSDK.typeName),
-		Methods:   mapFrom(statementSDK.methods, fromSDKMethod),
-		Extends:   extends,
-		// Reserved:
-		// ReservedNames:
+		Reference:              fromTypeUID(statementSDK.meta.uid),
+		Name:                   fromTypeName(&statementSDK.typeName),
+		Methods:                mapFrom(statementSDK.methods, fromSDKMethod),
+		Extends:                extends,
+		Reserved:               reserved,
+		ReservedNames:          reservedNames,
 		CommentBlock:           fromCommentBlock(statementSDK.meta.comments),
 		AnnotationApplications: fromAnnotationApplication(statementSDK.meta.annotationApplication),
+		Location:               fromTokenLocation(&statementSDK.typeName.identifier, statementSDK.loc),
 	}
 }
 
@@ -247,6 +259,9 @@ func fromAPIMethod(apiMethod *astAPIMethod) *proto.APIMethod {
 		Output:                 fromTypeSpecifier(&apiMethod.methodReturns.typeSpecifier),
 		CommentBlock:           fromCommentBlock(apiMethod.meta.comments),
 		AnnotationApplications: fromAnnotationApplication(apiMethod.meta.annotationApplication),
+		Location:               fromTokenLocation(&apiMethod.identifier, apiMethod.loc),
+		InputStream:            apiMethod.methodInput.stream,
+		OutputStream:           apiMethod.methodReturns.stream,
 	}
 }
 
@@ -264,6 +279,7 @@ func fromSDKMethod(sdkMethod *astSDKMethod) *proto.SDKMethod {
 		NoThrows:               sdkMethod.nothrows,
 		CommentBlock:           fromCommentBlock(sdkMethod.meta.comments),
 		AnnotationApplications: fromAnnotationApplication(sdkMethod.meta.annotationApplication),
+		Location:               fromTokenLocation(&sdkMethod.identifier, sdkMethod.loc),
 	}
 }
 
@@ -274,6 +290,7 @@ func fromSDKMethodParameter(sdkMethodParameter *astSDKMethodParameter) *proto.SD
 		Reference: fromInputUID(nil),
 		Name:      sdkMethodParameter.identifier.Value,
 		Type:      fromTypeSpecifier(&sdkMethodParameter.typeSpecifier),
+		Location:  fromTokenLocation(&sdkMethodParameter.identifier, sdkMethodParameter.loc),
 	}
 }
 
@@ -286,6 +303,7 @@ func fromField(field *astField) *proto.Field {
 		UnionIndex:             nil,
 		CommentBlock:           fromCommentBlock(field.meta.comments),
 		AnnotationApplications: fromAnnotationApplication(field.meta.annotationApplication),
+		Location:               fromTokenLocation(&field.identifier, field.loc),
 	}
 }
 
@@ -295,6 +313,7 @@ func fromUnion(union *astUnion) *proto.Union {
 		Name:                   union.identifier.Value,
 		CommentBlock:           fromCommentBlock(union.meta.comments),
 		AnnotationApplications: fromAnnotationApplication(union.meta.annotationApplication),
+		Location:               fromTokenLocation(union.identifier, union.loc),
 	}
 }
 
@@ -307,6 +326,7 @@ func fromUnionField(unionField *astUnionField, unionIndex uint64) *proto.Field {
 		UnionIndex:             &unionIndex,
 		CommentBlock:           fromCommentBlock(unionField.meta.comments),
 		AnnotationApplications: fromAnnotationApplication(unionField.meta.annotationApplication),
+		Location:               fromTokenLocation(&unionField.identifier, unionField.loc),
 	}
 }
 
@@ -316,13 +336,16 @@ func fromEnumerant(enumerant *astEnumerant) *proto.Enumerant {
 		Name:                   enumerant.identifier.Value,
 		CommentBlock:           fromCommentBlock(enumerant.meta.comments),
 		AnnotationApplications: fromAnnotationApplication(enumerant.meta.annotationApplication),
+		Location:               fromTokenLocation(&enumerant.identifier, enumerant.loc),
 	}
 }
 
 func fromTypeSpecifier(typeSpecifier *astTypeSpecifier) *proto.TypeSpecifier {
 	qualifier := ""
+	location := fromTokenLocation(&typeSpecifier.typeName.identifier, typeSpecifier.loc)
 	if typeSpecifier.qualifier != nil {
 		qualifier = typeSpecifier.qualifier.Value
+		location = fromTokenLocation(typeSpecifier.qualifier, typeSpecifier.loc)
 	}
 
 	return &proto.TypeSpecifier{
@@ -336,6 +359,7 @@ func fromTypeSpecifier(typeSpecifier *astTypeSpecifier) *proto.TypeSpecifier {
 				},
 			},
 		},
+		Location: location,
 	}
 }
 
@@ -355,14 +379,15 @@ func fromAnnotationScope(annotationScope *astAnnotationScope) proto.AnnotationSc
 		return proto.AnnotationScope_AnnotationScopeEnum
 	case idl.TokenTypeKeywordAPI:
 		return proto.AnnotationScope_AnnotationScopeAPI
-	// TODO 2023.09.05: missing from the lexer and parser
-	// case idl.TokenTypeKeywordAPIMethod:
-	//   return proto.AnnotationScope_AnnotationScopeAPIMethod
 	case idl.TokenTypeKeywordSDK:
 		return proto.AnnotationScope_AnnotationScopeSDK
-	// TODO 2023.09.05: missing from the lexer and parser
-	// case idl.TokenTypeKeywordSDKMethod:
-	//   return proto.AnnotationScope_AnnotationScopeSDKMethod
+	case idl.TokenTypeIdentifier:
+		switch annotationScope.scope.Value {
+		case "apimethod":
+			return proto.AnnotationScope_AnnotationScopeAPIMethod
+		case "sdkmethod":
+			return proto.AnnotationScope_AnnotationScopeSDKMethod
+		}
 	case idl.TokenTypeKeywordConst:
 		return proto.AnnotationScope_AnnotationScopeConst
 	case idl.TokenTypeStar:
@@ -376,16 +401,19 @@ func fromAnnotationScopes(annotationScopes []astAnnotationScope) []proto.Annotat
 }
 
 func fromAnnotationInstance(annotationInstance *astAnnotationInstance) *proto.AnnotationApplication {
+	// This is admittedly weird, but the pseudo-type specifiers in annotation applications
+	// are grammatically slightly different from a full-blown type specifier.
+	annotation := fromTypeSpecifier(&astTypeSpecifier{
+		astNode:   annotationInstance.astNode,
+		qualifier: annotationInstance.namespaceIdentifier,
+		typeName: astTypeName{
+			identifier: annotationInstance.identifier,
+		},
+	})
 	return &proto.AnnotationApplication{
-		// This is admittedly weird, but the pseudo-type specifiers in annotation applications
-		// are grammatically slightly different from a full-blown type specifier.
-		Annotation: fromTypeSpecifier(&astTypeSpecifier{
-			qualifier: annotationInstance.namespaceIdentifier,
-			typeName: astTypeName{
-				identifier: annotationInstance.identifier,
-			},
-		}),
-		Value: fromValue(&annotationInstance.value),
+		Annotation: annotation,
+		Value:      fromValue(&annotationInstance.value),
+		Location:   annotation.Location,
 	}
 }
 
@@ -459,6 +487,7 @@ func fromValue(value *astValue) *proto.Value {
 
 	switch v := value.value.(type) {
 	case astValueUnary:
+		this.Location = fromTokenLocation(&v.operator, v.loc)
 		this.Kind = &proto.Value_Unary{
 			Unary: &proto.ValueUnary{
 				Operation: fromOperationUnary(&v.operator),
@@ -466,14 +495,20 @@ func fromValue(value *astValue) *proto.Value {
 			},
 		}
 	case astValueBinary:
+		left := fromValue(&v.leftOperand)
+		this.Location = fromTokenLocation(&v.operator, v.loc)
+		if left != nil && left.Location != nil {
+			this.Location = left.Location
+		}
 		this.Kind = &proto.Value_Binary{
 			Binary: &proto.ValueBinary{
 				Operation: fromOperationBinary(&v.operator),
-				Left:      fromValue(&v.leftOperand),
+				Left:      left,
 				Right:     fromValue(&v.rightOperand),
 			},
 		}
 	case astValueLiteralBool:
+		this.Location = fromLocation(v.loc)
 		this.Kind = &proto.Value_Bool{
 			Bool: &proto.ValueBool{
 				Value: v.val,
@@ -481,6 +516,7 @@ func fromValue(value *astValue) *proto.Value {
 			},
 		}
 	case astValueLiteralInt:
+		this.Location = fromTokenLocation(&v.token, v.loc)
 		this.Kind = &proto.Value_Int32{
 			Int32: &proto.ValueInt32{
 				Value:  (int32)(v.val),
@@ -488,6 +524,7 @@ func fromValue(value *astValue) *proto.Value {
 			},
 		}
 	case astValueLiteralFloat:
+		this.Location = fromTokenLocation(&v.token, v.loc)
 		this.Kind = &proto.Value_Float64{
 			Float64: &proto.ValueFloat64{
 				Value:  v.val,
@@ -495,6 +532,7 @@ func fromValue(value *astValue) *proto.Value {
 			},
 		}
 	case astValueLiteralText:
+		this.Location = fromTokenLocation(&v.val, v.loc)
 		this.Kind = &proto.Value_Text{
 			Text: &proto.ValueText{
 				Value:  v.val.Value,
@@ -502,6 +540,7 @@ func fromValue(value *astValue) *proto.Value {
 			},
 		}
 	case astValueLiteralData:
+		this.Location = fromTokenLocation(&v.val, v.loc)
 		this.Kind = &proto.Value_Data{
 			Data: &proto.ValueData{
 				Value:  []byte(v.val.Value),
@@ -509,18 +548,31 @@ func fromValue(value *astValue) *proto.Value {
 			},
 		}
 	case astValueLiteralList:
+		this.Location = fromLocation(v.loc)
 		this.Kind = &proto.Value_List{
 			List: &proto.ValueList{
 				Elements: mapFrom(v.vals, fromValue),
 			},
 		}
+	case astValueLiteralMap:
+		this.Location = fromLocation(v.loc)
+		this.Kind = &proto.Value_Map{
+			Map: &proto.ValueMap{
+				Entries: mapFrom(v.vals, fromLiteralMapPair),
+			},
+		}
 	case astValueLiteralStruct:
+		this.Location = fromLocation(v.loc)
 		this.Kind = &proto.Value_Struct{
 			Struct: &proto.ValueStruct{
 				Fields: mapFrom(v.vals, fromLiteralStructPair),
 			},
 		}
 	case astValueIdentifier:
+		this.Location = fromLocation(v.loc)
+		if len(v.components) > 0 {
+			this.Location = fromTokenLocation(&v.components[0], v.loc)
+		}
 		this.Kind = &proto.Value_Identifier{
 			Identifier: fromValueIdentifier(&v),
 		}
@@ -531,6 +583,13 @@ func fromValue(value *astValue) *proto.Value {
 	return &this
 }
 
+func fromLiteralMapPair(literalMapPair *astLiteralMapPair) *proto.ValueMapEntry {
+	return &proto.ValueMapEntry{
+		Key:   fromValue(&literalMapPair.key),
+		Value: fromValue(&literalMapPair.value),
+	}
+}
+
 func fromLiteralStructPair(literalStructPair *astLiteralStructPair) *proto.ValueStructField {
 	return &proto.ValueStructField{
 		Name:  literalStructPair.identifier.Value,
@@ -551,6 +610,30 @@ func fromTypeName(typeName *astTypeName) *proto.TypeName {
 	}
 }
 
+func fromReserved(reserved *astReserved) ([]*proto.ReservedRange, []string) {
+	if reserved == nil {
+		return nil, nil
+	}
+	var ranges []*proto.ReservedRange
+	var names []string
+	for _, element := range reserved.elements {
+		switch e := element.(type) {
+		case astReservedRange:
+			end := e.start.val
+			if e.end != nil {
+				end = e.end.val
+			}
+			ranges = append(ranges, &proto.ReservedRange{
+				Start: e.start.val,
+				End:   end,
+			})
+		case astValueLiteralText:
+			names = append(names, e.val.Value)
+		}
+	}
+	return ranges, names
+}
+
 func fromTypeUID(typeUID *astValueLiteralInt) *proto.TypeReference {
 	this := proto.TypeReference{
 		ModuleUID: idl.Incomplete,
@@ -589,3 +672,20 @@ func fromInputUID(inputUID *astValueLiteralInt) *proto.SDKInputReference {
 	}
 	return &this
 }
+
+func fromLocation(location idl.Location) *proto.SourceLocation {
+	return &proto.SourceLocation{
+		Line:   location.Line,
+		Column: location.Column,
+		Offset: location.Offset,
+	}
+}
+
+// fromTokenLocation prefers the start of the given token over the location of
+// the AST node, which is recorded where the node ends.
+func fromTokenLocation(token *idl.Token, fallback idl.Location) *proto.SourceLocation {
+	if token == nil || token.Span == nil || token.Span.Start == nil {
+		return fromLocation(fallback)
+	}
+	return fromLocation(*token.Span.Start)
+}
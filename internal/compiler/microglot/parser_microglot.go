@@ -59,9 +59,18 @@ type parserMicroglotTokens struct {
 	// so that we can give a meaningful location to "unexpected EOF" errors.
 	loc    idl.Location
 	tokens idl.Lookahead[*idl.Token]
+	// failed is set once any error has been reported, since recovery means that
+	// ParseModule() returns a (partial) AST even when parsing didn't succeed.
+	failed bool
+}
+
+// Failed reports whether any errors were encountered while parsing.
+func (p *parserMicroglotTokens) Failed() bool {
+	return p.failed
 }
 
 func (p *parserMicroglotTokens) report(code string, message string) {
+	p.failed = true
 	_ = p.reporter.Report(exc.New(exc.Location{
 		URI:      p.uri,
 		Location: p.loc,
@@ -149,9 +158,17 @@ func applyOverCommaSeparatedList[N node](p *parserMicroglotTokens, tOpen idl.Tok
 			return nil
 		}
 		values = append(values, *maybeValue)
+	}
+	return applyOverCommaSeparatedRest(p, values, parser, tClose)
+}
 
+// applyOverCommaSeparatedRest parses the rest of a comma separated list whose
+// opening token and first values have been parsed already, which are given.
+// An empty list of values must be followed by the closing token.
+func applyOverCommaSeparatedRest[N node](p *parserMicroglotTokens, values []N, parser func() *N, tClose idl.TokenType) []N {
+	if len(values) > 0 {
 		for {
-			maybeToken = p.peek()
+			maybeToken := p.peek()
 			if maybeToken == nil {
 				p.report(exc.CodeUnexpectedEOF, fmt.Sprintf("unexpected EOF (expecting a list of %T)", values))
 				return nil
@@ -173,7 +190,7 @@ func applyOverCommaSeparatedList[N node](p *parserMicroglotTokens, tOpen idl.Tok
 				break
 			}
 
-			maybeValue = parser()
+			maybeValue := parser()
 			if maybeValue == nil {
 				return nil
 			}
@@ -188,7 +205,9 @@ func applyOverCommaSeparatedList[N node](p *parserMicroglotTokens, tOpen idl.Tok
 	return values
 }
 
-func applyOverCommentedBlockWithPrefix[N node, P node](p *parserMicroglotTokens, prefixToken idl.TokenType, prefixParser func() *P, valueParser func() *N) *astCommentedBlock[N, P] {
+// applyOverCommentedBlockWithPrefix parses a block, which may start with a
+// prefix that atPrefix recognizes, before any of its values.
+func applyOverCommentedBlockWithPrefix[N node, P node](p *parserMicroglotTokens, atPrefix func() bool, prefixParser func() *P, valueParser func() *N) *astCommentedBlock[N, P] {
 	if p.expectOne(idl.TokenTypeCurlyOpen) == nil {
 		return nil
 	}
@@ -204,15 +223,12 @@ func applyOverCommentedBlockWithPrefix[N node, P node](p *parserMicroglotTokens,
 		this.innerComments = maybeCommentBlock
 	}
 
-	if prefixParser != nil {
-		maybeToken := p.peek()
-		if maybeToken != nil && maybeToken.Type == prefixToken {
-			maybePrefix := prefixParser()
-			if maybePrefix == nil {
-				return nil
-			}
-			this.prefix = maybePrefix
+	if prefixParser != nil && atPrefix() {
+		maybePrefix := prefixParser()
+		if maybePrefix == nil {
+			return nil
 		}
+		this.prefix = maybePrefix
 	}
 
 	for {
@@ -223,7 +239,10 @@ func applyOverCommentedBlockWithPrefix[N node, P node](p *parserMicroglotTokens,
 
 		maybeValue := valueParser()
 		if maybeValue == nil {
-			return nil
+			if !p.synchronizeBlock() {
+				return nil
+			}
+			continue
 		}
 		this.values = append(this.values, *maybeValue)
 	}
@@ -236,10 +255,16 @@ func applyOverCommentedBlockWithPrefix[N node, P node](p *parserMicroglotTokens,
 }
 
 func applyOverCommentedBlock[N node](p *parserMicroglotTokens, parser func() *N) *astCommentedBlock[N, node] {
-	return applyOverCommentedBlockWithPrefix[N, node](p, idl.TokenTypeNewline, nil, parser)
+	return applyOverCommentedBlockWithPrefix[N, node](p, nil, nil, parser)
 }
 
 // Module = [CommentBlock] StatementSyntax { Statement }
+//
+// Errors in individual statements don't stop the parse. Instead, the parser
+// skips ahead to the next element of the enclosing block, or to the next
+// statement, and carries on, so that every syntax error in the file gets
+// reported. The returned module contains all of the
+// statements that did parse; check Failed() to find out if any didn't.
 func (p *parserMicroglotTokens) ParseModule() *astModule {
 	this := astModule{
 		URI: p.uri,
@@ -249,16 +274,18 @@ func (p *parserMicroglotTokens) ParseModule() *astModule {
 	if maybeToken != nil && maybeToken.Type == idl.TokenTypeComment {
 		maybeCommentBlock := p.parseCommentBlock()
 		if maybeCommentBlock == nil {
-			return nil
+			p.synchronize()
+		} else {
+			this.comments = maybeCommentBlock
 		}
-		this.comments = maybeCommentBlock
 	}
 
 	syntax := p.parseStatementSyntax()
 	if syntax == nil {
-		return nil
+		p.synchronize()
+	} else {
+		this.syntax = *syntax
 	}
-	this.syntax = *syntax
 
 	for {
 		maybeToken := p.peek()
@@ -269,70 +296,137 @@ func (p *parserMicroglotTokens) ParseModule() *astModule {
 		var maybeStatement statement
 		switch maybeToken.Type {
 		case idl.TokenTypeKeywordModule:
-			maybeStatementModuleMeta := p.parseStatementModuleMeta()
-			if maybeStatementModuleMeta == nil {
-				return nil
+			if maybeStatementModuleMeta := p.parseStatementModuleMeta(); maybeStatementModuleMeta != nil {
+				maybeStatement = maybeStatementModuleMeta
 			}
-			maybeStatement = maybeStatementModuleMeta
 		case idl.TokenTypeKeywordImport:
-			maybeStatementImport := p.parseStatementImport()
-			if maybeStatementImport == nil {
-				return nil
+			if maybeStatementImport := p.parseStatementImport(); maybeStatementImport != nil {
+				maybeStatement = maybeStatementImport
 			}
-			maybeStatement = maybeStatementImport
 		case idl.TokenTypeKeywordAnnotation:
-			maybeStatementAnnotation := p.parseStatementAnnotation()
-			if maybeStatementAnnotation == nil {
-				return nil
+			if maybeStatementAnnotation := p.parseStatementAnnotation(); maybeStatementAnnotation != nil {
+				maybeStatement = maybeStatementAnnotation
 			}
-			maybeStatement = maybeStatementAnnotation
 		case idl.TokenTypeKeywordConst:
-			maybeStatementConst := p.parseStatementConst()
-			if maybeStatementConst == nil {
-				return nil
+			if maybeStatementConst := p.parseStatementConst(); maybeStatementConst != nil {
+				maybeStatement = maybeStatementConst
 			}
-			maybeStatement = maybeStatementConst
 		case idl.TokenTypeKeywordEnum:
-			maybeStatementEnum := p.parseStatementEnum()
-			if maybeStatementEnum == nil {
-				return nil
+			if maybeStatementEnum := p.parseStatementEnum(); maybeStatementEnum != nil {
+				maybeStatement = maybeStatementEnum
 			}
-			maybeStatement = maybeStatementEnum
 		case idl.TokenTypeKeywordStruct:
-			maybeStatementStruct := p.parseStatementStruct()
-			if maybeStatementStruct == nil {
-				return nil
+			if maybeStatementStruct := p.parseStatementStruct(); maybeStatementStruct != nil {
+				maybeStatement = maybeStatementStruct
 			}
-			maybeStatement = maybeStatementStruct
 		case idl.TokenTypeKeywordAPI:
-			maybeStatementAPI := p.parseStatementAPI()
-			if maybeStatementAPI == nil {
-				return nil
+			if maybeStatementAPI := p.parseStatementAPI(); maybeStatementAPI != nil {
+				maybeStatement = maybeStatementAPI
 			}
-			maybeStatement = maybeStatementAPI
 		case idl.TokenTypeKeywordSDK:
-			maybeStatementSDK := p.parseStatementSDK()
-			if maybeStatementSDK == nil {
-				return nil
+			if maybeStatementSDK := p.parseStatementSDK(); maybeStatementSDK != nil {
+				maybeStatement = maybeStatementSDK
 			}
-			maybeStatement = maybeStatementSDK
 		case idl.TokenTypeKeywordImpl:
-			maybeStatementImpl := p.parseStatementImpl()
-			if maybeStatementImpl == nil {
-				return nil
+			if maybeStatementImpl := p.parseStatementImpl(); maybeStatementImpl != nil {
+				maybeStatement = maybeStatementImpl
 			}
-			maybeStatement = maybeStatementImpl
 		default:
 			p.report(exc.CodeUnexpectedToken, fmt.Sprintf("unexpected %s (expecting a statement)", maybeToken.Value))
-			return nil
 		}
 
+		if maybeStatement == nil {
+			p.synchronize()
+			continue
+		}
 		this.statements = append(this.statements, maybeStatement)
 	}
 
 	return &this
 }
 
+// statementKeywords are the tokens that may begin a top-level statement.
+var statementKeywords = []idl.TokenType{
+	idl.TokenTypeKeywordModule,
+	idl.TokenTypeKeywordImport,
+	idl.TokenTypeKeywordAnnotation,
+	idl.TokenTypeKeywordConst,
+	idl.TokenTypeKeywordEnum,
+	idl.TokenTypeKeywordStruct,
+	idl.TokenTypeKeywordAPI,
+	idl.TokenTypeKeywordSDK,
+	idl.TokenTypeKeywordImpl,
+}
+
+// synchronize skips tokens after a parse error until the start of the next
+// statement, or EOF. Statement keywords are also valid inside of some
+// statements (e.g. annotation scopes), so they are only considered once any
+// brackets opened while skipping have been closed again. Unmatched closing
+// brackets belong to the statement that failed and are skipped along with it.
+func (p *parserMicroglotTokens) synchronize() {
+	depth := 0
+	for {
+		maybeToken := p.peek()
+		if maybeToken == nil {
+			return
+		}
+		switch maybeToken.Type {
+		case idl.TokenTypeCurlyOpen, idl.TokenTypeParenOpen, idl.TokenTypeSquareOpen:
+			depth++
+		case idl.TokenTypeCurlyClose, idl.TokenTypeParenClose, idl.TokenTypeSquareClose:
+			if depth > 0 {
+				depth--
+			}
+		default:
+			if depth == 0 && slices.Contains(statementKeywords, maybeToken.Type) {
+				return
+			}
+		}
+		p.advance()
+	}
+}
+
+// synchronizeBlock skips tokens after a parse error in an element of a block
+// until the start of the next element, which is the first token on a later
+// line than the one the error was found at, or the brace that closes the
+// block. Brackets are tracked the same way as synchronize() does. It returns
+// false if it reaches EOF or a statement keyword instead, since then the
+// closing brace is missing and the whole statement has to be given up on.
+func (p *parserMicroglotTokens) synchronizeBlock() bool {
+	maybeToken := p.peek()
+	if maybeToken == nil {
+		return false
+	}
+	line := maybeToken.Span.Start.Line
+	depth := 0
+	for {
+		maybeToken := p.peek()
+		if maybeToken == nil {
+			return false
+		}
+		if depth == 0 && maybeToken.Span.Start.Line > line {
+			if slices.Contains(statementKeywords, maybeToken.Type) {
+				return false
+			}
+			return true
+		}
+		switch maybeToken.Type {
+		case idl.TokenTypeCurlyOpen, idl.TokenTypeParenOpen, idl.TokenTypeSquareOpen:
+			depth++
+		case idl.TokenTypeCurlyClose:
+			if depth == 0 {
+				return true
+			}
+			depth--
+		case idl.TokenTypeParenClose, idl.TokenTypeSquareClose:
+			if depth > 0 {
+				depth--
+			}
+		}
+		p.advance()
+	}
+}
+
 // StatementSyntax = "syntax" "=" text_lit
 func (p *parserMicroglotTokens) parseStatementSyntax() *astStatementSyntax {
 	if p.expectOne(idl.TokenTypeKeywordSyntax) == nil {
@@ -516,7 +610,7 @@ func (p *parserMicroglotTokens) parseStatementConst() *astStatementConst {
 	}
 }
 
-// StatementEnum = enum identifier brace_open [CommentBlock] { Enumerant } brace_close Metadata .
+// StatementEnum = enum identifier brace_open [CommentBlock] [Reserved] { Enumerant } brace_close Metadata .
 func (p *parserMicroglotTokens) parseStatementEnum() *astStatementEnum {
 	if p.expectOne(idl.TokenTypeKeywordEnum) == nil {
 		return nil
@@ -527,7 +621,7 @@ func (p *parserMicroglotTokens) parseStatementEnum() *astStatementEnum {
 		return nil
 	}
 
-	commentedBlock := applyOverCommentedBlock(p, p.parseEnumerant)
+	commentedBlock := applyOverCommentedBlockWithPrefix(p, p.atEnumReserved, p.parseReserved, p.parseEnumerant)
 	if commentedBlock == nil {
 		return nil
 	}
@@ -540,13 +634,14 @@ func (p *parserMicroglotTokens) parseStatementEnum() *astStatementEnum {
 	return &astStatementEnum{
 		astNode:       astNode{p.loc},
 		identifier:    *maybeIdentifier,
+		reserved:      commentedBlock.prefix,
 		enumerants:    commentedBlock.values,
 		innerComments: commentedBlock.innerComments,
 		meta:          *maybeMeta,
 	}
 }
 
-// StatementStruct = struct TypeName brace_open [CommentBlock] { StructElement } brace_close Metadata .
+// StatementStruct = struct TypeName brace_open [CommentBlock] [Reserved] { StructElement } brace_close Metadata .
 func (p *parserMicroglotTokens) parseStatementStruct() *astStatementStruct {
 	if p.expectOne(idl.TokenTypeKeywordStruct) == nil {
 		return nil
@@ -557,7 +652,7 @@ func (p *parserMicroglotTokens) parseStatementStruct() *astStatementStruct {
 		return nil
 	}
 
-	commentedBlock := applyOverCommentedBlock(p, p.parseStructElement)
+	commentedBlock := applyOverCommentedBlockWithPrefix(p, p.atReserved, p.parseReserved, p.parseStructElement)
 	if commentedBlock == nil {
 		return nil
 	}
@@ -571,12 +666,13 @@ func (p *parserMicroglotTokens) parseStatementStruct() *astStatementStruct {
 		astNode:       astNode{p.loc},
 		typeName:      *maybeTypeName,
 		innerComments: commentedBlock.innerComments,
+		reserved:      commentedBlock.prefix,
 		elements:      commentedBlock.values,
 		meta:          *maybeMeta,
 	}
 }
 
-// StatementAPI = api TypeName [Extension] brace_open [CommentBlock] { APIMethod } brace_close Metadata .
+// StatementAPI = api TypeName [Extension] brace_open [CommentBlock] [Reserved] { APIMethod } brace_close Metadata .
 func (p *parserMicroglotTokens) parseStatementAPI() *astStatementAPI {
 	if p.expectOne(idl.TokenTypeKeywordAPI) == nil {
 		return nil
@@ -600,11 +696,12 @@ func (p *parserMicroglotTokens) parseStatementAPI() *astStatementAPI {
 		this.extends = maybeExtends
 	}
 
-	commentedBlock := applyOverCommentedBlock(p, p.parseAPIMethod)
+	commentedBlock := applyOverCommentedBlockWithPrefix(p, p.atReserved, p.parseReserved, p.parseAPIMethod)
 	if commentedBlock == nil {
 		return nil
 	}
 	this.innerComments = commentedBlock.innerComments
+	this.reserved = commentedBlock.prefix
 	this.methods = commentedBlock.values
 
 	maybeMeta := p.parseMetadata()
@@ -617,7 +714,7 @@ func (p *parserMicroglotTokens) parseStatementAPI() *astStatementAPI {
 	return &this
 }
 
-// StatementSDK = sdk TypeName [Extension] brace_open [CommentBlock] { SDKMethod } brace_close Metadata .
+// StatementSDK = sdk TypeName [Extension] brace_open [CommentBlock] [Reserved] { SDKMethod } brace_close Metadata .
 func (p *parserMicroglotTokens) parseStatementSDK() *astStatementSDK {
 	if p.expectOne(idl.TokenTypeKeywordSDK) == nil {
 		return nil
@@ -641,11 +738,12 @@ func (p *parserMicroglotTokens) parseStatementSDK() *astStatementSDK {
 		this.extends = maybeExtends
 	}
 
-	commentedBlock := applyOverCommentedBlock(p, p.parseSDKMethod)
+	commentedBlock := applyOverCommentedBlockWithPrefix(p, p.atReserved, p.parseReserved, p.parseSDKMethod)
 	if commentedBlock == nil {
 		return nil
 	}
 	this.innerComments = commentedBlock.innerComments
+	this.reserved = commentedBlock.prefix
 	this.methods = commentedBlock.values
 
 	maybeMeta := p.parseMetadata()
@@ -679,7 +777,7 @@ func (p *parserMicroglotTokens) parseStatementImpl() *astStatementImpl {
 		as:       *maybeImplAs,
 	}
 
-	commentedBlock := applyOverCommentedBlockWithPrefix(p, idl.TokenTypeKeywordRequires, p.parseImplRequires, p.parseImplMethod)
+	commentedBlock := applyOverCommentedBlockWithPrefix(p, p.atImplRequires, p.parseImplRequires, p.parseImplMethod)
 	if commentedBlock == nil {
 		return nil
 	}
@@ -1258,30 +1356,54 @@ func (p *parserMicroglotTokens) parseImplBlockStep() *step {
 	var value step
 	switch maybeToken.Type {
 	case idl.TokenTypeProse:
-		value = p.parseStepProse()
+		if maybeStep := p.parseStepProse(); maybeStep != nil {
+			value = maybeStep
+		}
 	case idl.TokenTypeKeywordVar:
-		value = p.parseStepVar()
+		if maybeStep := p.parseStepVar(); maybeStep != nil {
+			value = maybeStep
+		}
 	case idl.TokenTypeKeywordSet:
-		value = p.parseStepSet()
+		if maybeStep := p.parseStepSet(); maybeStep != nil {
+			value = maybeStep
+		}
 	case idl.TokenTypeKeywordIf:
-		value = p.parseStepIf()
+		if maybeStep := p.parseStepIf(); maybeStep != nil {
+			value = maybeStep
+		}
 	case idl.TokenTypeKeywordSwitch:
-		value = p.parseStepSwitch()
+		if maybeStep := p.parseStepSwitch(); maybeStep != nil {
+			value = maybeStep
+		}
 	case idl.TokenTypeKeywordWhile:
-		value = p.parseStepWhile()
+		if maybeStep := p.parseStepWhile(); maybeStep != nil {
+			value = maybeStep
+		}
 	case idl.TokenTypeKeywordFor:
-		value = p.parseStepFor()
+		if maybeStep := p.parseStepFor(); maybeStep != nil {
+			value = maybeStep
+		}
 	case idl.TokenTypeKeywordReturn:
-		value = p.parseStepReturn()
+		if maybeStep := p.parseStepReturn(); maybeStep != nil {
+			value = maybeStep
+		}
 	case idl.TokenTypeKeywordThrow:
-		value = p.parseStepThrow()
+		if maybeStep := p.parseStepThrow(); maybeStep != nil {
+			value = maybeStep
+		}
 	case idl.TokenTypeKeywordExec:
-		value = p.parseStepExec()
+		if maybeStep := p.parseStepExec(); maybeStep != nil {
+			value = maybeStep
+		}
 	default:
 		p.report(exc.CodeUnexpectedToken, fmt.Sprintf("unexpected %s (expecting an implementation step)", maybeToken.Value))
 		return nil
 	}
 
+	if value == nil {
+		return nil
+	}
+
 	return &value
 }
 
@@ -1452,6 +1574,106 @@ func (p *parserMicroglotTokens) parseImplRequires() *astImplRequires {
 	}
 }
 
+// atReserved reports whether the parser is at the start of a Reserved clause.
+// "reserved" isn't a keyword, so that it remains usable as a name, but a field
+// or method named "reserved" can't be followed by a UID or text literal.
+func (p *parserMicroglotTokens) atReserved() bool {
+	maybeToken := p.peek()
+	if maybeToken == nil || maybeToken.Type != idl.TokenTypeIdentifier || maybeToken.Value != "reserved" {
+		return false
+	}
+	maybeNext := p.peekN(1)
+	return maybeNext != nil && (maybeNext.Type == idl.TokenTypeText || maybeNext.Type == idl.TokenTypeAt)
+}
+
+// atEnumReserved is atReserved for enums, where an enumerant named "reserved"
+// may be followed by its UID. "reserved @N" is read as an enumerant unless
+// another element of the clause follows, so a single UID is reserved with
+// "reserved @N to @N".
+func (p *parserMicroglotTokens) atEnumReserved() bool {
+	if !p.atReserved() {
+		return false
+	}
+	if p.peekN(1).Type == idl.TokenTypeText {
+		return true
+	}
+	maybeNext := p.peekN(3)
+	return maybeNext != nil && (maybeNext.Type == idl.TokenTypeComma || (maybeNext.Type == idl.TokenTypeIdentifier && maybeNext.Value == "to"))
+}
+
+func (p *parserMicroglotTokens) atImplRequires() bool {
+	maybeToken := p.peek()
+	return maybeToken != nil && maybeToken.Type == idl.TokenTypeKeywordRequires
+}
+
+// Reserved = reserved ReservedElement { comma ReservedElement } .
+func (p *parserMicroglotTokens) parseReserved() *astReserved {
+	maybeIdentifier := p.expectOne(idl.TokenTypeIdentifier)
+	if maybeIdentifier == nil {
+		return nil
+	}
+	if maybeIdentifier.Value != "reserved" {
+		p.report(exc.CodeUnexpectedToken, fmt.Sprintf("unexpected %s (expecting reserved)", maybeIdentifier.Value))
+		return nil
+	}
+
+	this := astReserved{}
+	for {
+		maybeElement := p.parseReservedElement()
+		if maybeElement == nil {
+			return nil
+		}
+		this.elements = append(this.elements, *maybeElement)
+
+		maybeToken := p.peek()
+		if maybeToken == nil || maybeToken.Type != idl.TokenTypeComma {
+			break
+		}
+		p.advance()
+	}
+
+	this.loc = p.loc
+	return &this
+}
+
+// ReservedElement = UID [to UID] | text_lit .
+//
+// The "to" isn't a keyword so that it remains available as an identifier.
+func (p *parserMicroglotTokens) parseReservedElement() *reservedelement {
+	var value reservedelement
+	maybeToken := p.peek()
+	if maybeToken != nil && maybeToken.Type == idl.TokenTypeText {
+		maybeText := p.parseValueLiteralText()
+		if maybeText == nil {
+			return nil
+		}
+		value = *maybeText
+		return &value
+	}
+
+	maybeStart := p.parseUID()
+	if maybeStart == nil {
+		return nil
+	}
+	this := astReservedRange{
+		start: *maybeStart,
+	}
+
+	maybeToken = p.peek()
+	if maybeToken != nil && maybeToken.Type == idl.TokenTypeIdentifier && maybeToken.Value == "to" {
+		p.advance()
+		maybeEnd := p.parseUID()
+		if maybeEnd == nil {
+			return nil
+		}
+		this.end = maybeEnd
+	}
+
+	this.loc = p.loc
+	value = this
+	return &value
+}
+
 // ImplAs = as paren_open TypeSpecifier { comma TypeSpecifier } [comma] paren_close .
 func (p *parserMicroglotTokens) parseImplAs() *astImplAs {
 	if p.expectOne(idl.TokenTypeKeywordAs) == nil {
@@ -1591,11 +1813,12 @@ func (p *parserMicroglotTokens) parseExtension() *astExtension {
 	}
 }
 
-// APIMethodInput = paren_open TypeSpecifier paren_close .
+// APIMethodInput = paren_open [stream] TypeSpecifier paren_close .
 func (p *parserMicroglotTokens) parseAPIMethodInput() *astAPIMethodInput {
 	if p.expectOne(idl.TokenTypeParenOpen) == nil {
 		return nil
 	}
+	stream := p.parseStream()
 	maybeTypeSpecifier := p.parseTypeSpecifier()
 	if maybeTypeSpecifier == nil {
 		return nil
@@ -1606,11 +1829,12 @@ func (p *parserMicroglotTokens) parseAPIMethodInput() *astAPIMethodInput {
 
 	return &astAPIMethodInput{
 		astNode:       astNode{p.loc},
+		stream:        stream,
 		typeSpecifier: *maybeTypeSpecifier,
 	}
 }
 
-// APIMethodReturns = returns paren_open TypeSpecifier paren_close .
+// APIMethodReturns = returns paren_open [stream] TypeSpecifier paren_close .
 func (p *parserMicroglotTokens) parseAPIMethodReturns() *astAPIMethodReturns {
 	if p.expectOne(idl.TokenTypeKeywordReturns) == nil {
 		return nil
@@ -1618,6 +1842,7 @@ func (p *parserMicroglotTokens) parseAPIMethodReturns() *astAPIMethodReturns {
 	if p.expectOne(idl.TokenTypeParenOpen) == nil {
 		return nil
 	}
+	stream := p.parseStream()
 	maybeTypeSpecifier := p.parseTypeSpecifier()
 	if maybeTypeSpecifier == nil {
 		return nil
@@ -1627,10 +1852,24 @@ func (p *parserMicroglotTokens) parseAPIMethodReturns() *astAPIMethodReturns {
 	}
 	return &astAPIMethodReturns{
 		astNode:       astNode{p.loc},
+		stream:        stream,
 		typeSpecifier: *maybeTypeSpecifier,
 	}
 }
 
+// parseStream consumes the stream marker of an API method input or output if
+// there is one. It isn't a keyword, so that it remains usable as a name
+// elsewhere, but it can't be confused with a type specifier, which starts with
+// a colon.
+func (p *parserMicroglotTokens) parseStream() bool {
+	maybeToken := p.peek()
+	if maybeToken != nil && maybeToken.Type == idl.TokenTypeIdentifier && maybeToken.Value == "stream" {
+		p.advance()
+		return true
+	}
+	return false
+}
+
 // APIMethod = identifier APIMethodInput APIMethodReturns Metadata .
 func (p *parserMicroglotTokens) parseAPIMethod() *astAPIMethod {
 	maybeIdentifier := p.expectOne(idl.TokenTypeIdentifier)
@@ -1667,9 +1906,17 @@ func (p *parserMicroglotTokens) parseStructElement() *structelement {
 	var value structelement
 	maybeToken := p.peek()
 	if maybeToken != nil && maybeToken.Type == idl.TokenTypeKeywordUnion {
-		value = p.parseUnion()
+		maybeUnion := p.parseUnion()
+		if maybeUnion == nil {
+			return nil
+		}
+		value = maybeUnion
 	} else {
-		value = p.parseField()
+		maybeField := p.parseField()
+		if maybeField == nil {
+			return nil
+		}
+		value = maybeField
 	}
 	return &value
 }
@@ -1807,8 +2054,19 @@ func (p *parserMicroglotTokens) parseMetadata() *astMetadata {
 }
 
 // AnnotationScope = module | union | struct | field | enumerant | enum | api | apimethod | sdk | sdkmethod | const | star .
+//
+// apimethod and sdkmethod aren't keywords, so that they remain usable as names
+// elsewhere.
 func (p *parserMicroglotTokens) parseAnnotationScope() *astAnnotationScope {
-	maybeToken := p.expectOneOf([]idl.TokenType{
+	maybeToken := p.peek()
+	if maybeToken != nil && maybeToken.Type == idl.TokenTypeIdentifier && (maybeToken.Value == "apimethod" || maybeToken.Value == "sdkmethod") {
+		p.advance()
+		return &astAnnotationScope{
+			astNode: astNode{p.loc},
+			scope:   *maybeToken,
+		}
+	}
+	maybeToken = p.expectOneOf([]idl.TokenType{
 		idl.TokenTypeKeywordModule,
 		idl.TokenTypeKeywordUnion,
 		idl.TokenTypeKeywordStruct,
@@ -1816,11 +2074,7 @@ func (p *parserMicroglotTokens) parseAnnotationScope() *astAnnotationScope {
 		idl.TokenTypeKeywordEnumerant,
 		idl.TokenTypeKeywordEnum,
 		idl.TokenTypeKeywordAPI,
-		// TODO 2023.08.22: appears to be missing from the lexer
-		// idl.TokenTypeKeywordAPIMethod,
 		idl.TokenTypeKeywordSDK,
-		// TODO 2023.08.22: appears to be missing from the lexer
-		// idl.TokenTypeKeywordSDKMethod,
 		idl.TokenTypeKeywordConst,
 		idl.TokenTypeStar,
 	})
@@ -2061,11 +2315,14 @@ func (p *parserMicroglotTokens) parseValue() *astValue {
 		}
 		this.value = *maybeValue
 	case idl.TokenTypeSquareOpen:
-		maybeValue := p.parseValueLiteralList()
-		if maybeValue == nil {
+		switch maybeValue := p.parseValueLiteralListOrMap().(type) {
+		case *astValueLiteralList:
+			this.value = *maybeValue
+		case *astValueLiteralMap:
+			this.value = *maybeValue
+		default:
 			return nil
 		}
-		this.value = *maybeValue
 	case idl.TokenTypeCurlyOpen:
 		maybeValue := p.parseValueLiteralStruct()
 		if maybeValue == nil {
@@ -2247,19 +2504,94 @@ func (p *parserMicroglotTokens) parseValueLiteralData() *astValueLiteralData {
 }
 
 // ValueLiteralList    = square_open [Value {comma Value} {comma}] square_close .
-func (p *parserMicroglotTokens) parseValueLiteralList() *astValueLiteralList {
-	values := applyOverCommaSeparatedList(p,
-		idl.TokenTypeSquareOpen,
-		p.parseValue,
-		idl.TokenTypeSquareClose,
-	)
-	if values == nil {
+// ValueLiteralMap     = square_open (colon | LiteralMapPair {comma LiteralMapPair} {comma}) square_close .
+//
+// Lists and maps are told apart by the colon that follows the first key of a
+// map, or that makes up an empty map on its own. The result is either an
+// *astValueLiteralList or an *astValueLiteralMap.
+func (p *parserMicroglotTokens) parseValueLiteralListOrMap() node {
+	if p.expectOne(idl.TokenTypeSquareOpen) == nil {
 		return nil
 	}
 
-	return &astValueLiteralList{
+	maybeToken := p.peek()
+	if maybeToken == nil {
+		p.report(exc.CodeUnexpectedEOF, "unexpected EOF (expecting a list or a map)")
+		return nil
+	}
+	switch maybeToken.Type {
+	case idl.TokenTypeColon:
+		p.advance()
+		if p.expectOne(idl.TokenTypeSquareClose) == nil {
+			return nil
+		}
+		return &astValueLiteralMap{
+			astNode: astNode{p.loc},
+			vals:    []astLiteralMapPair{},
+		}
+	case idl.TokenTypeSquareClose:
+		p.advance()
+		return &astValueLiteralList{
+			astNode: astNode{p.loc},
+			vals:    []astValue{},
+		}
+	}
+
+	first := p.parseValue()
+	if first == nil {
+		return nil
+	}
+	maybeToken = p.peek()
+	if maybeToken == nil || maybeToken.Type != idl.TokenTypeColon {
+		values := applyOverCommaSeparatedRest(p, []astValue{*first}, p.parseValue, idl.TokenTypeSquareClose)
+		if values == nil {
+			return nil
+		}
+		return &astValueLiteralList{
+			astNode: astNode{p.loc},
+			vals:    values,
+		}
+	}
+
+	p.advance()
+	maybeValue := p.parseValue()
+	if maybeValue == nil {
+		return nil
+	}
+	pairs := applyOverCommaSeparatedRest(p, []astLiteralMapPair{{
 		astNode: astNode{p.loc},
-		vals:    values,
+		key:     *first,
+		value:   *maybeValue,
+	}}, p.parseLiteralMapPair, idl.TokenTypeSquareClose)
+	if pairs == nil {
+		return nil
+	}
+	return &astValueLiteralMap{
+		astNode: astNode{p.loc},
+		vals:    pairs,
+	}
+}
+
+// LiteralMapPair = Value colon Value .
+func (p *parserMicroglotTokens) parseLiteralMapPair() *astLiteralMapPair {
+	maybeKey := p.parseValue()
+	if maybeKey == nil {
+		return nil
+	}
+
+	if p.expectOne(idl.TokenTypeColon) == nil {
+		return nil
+	}
+
+	maybeValue := p.parseValue()
+	if maybeValue == nil {
+		return nil
+	}
+
+	return &astLiteralMapPair{
+		astNode: astNode{p.loc},
+		key:     *maybeKey,
+		value:   *maybeValue,
 	}
 }
 
@@ -74,6 +74,47 @@ func TestParser(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:   "reserved",
+			input:  "reserved @2, @5 to @9, \"foo\"",
+			parser: func(p *parserMicroglotTokens) node { return p.parseReserved() },
+			expected: &astReserved{
+				astNode: astNode{idl.Location{Line: 1, Column: 28, Offset: 27}},
+				elements: []reservedelement{
+					astReservedRange{
+						astNode: astNode{idl.Location{Line: 1, Column: 11, Offset: 10}},
+						start: astValueLiteralInt{
+							astNode: astNode{idl.Location{Line: 1, Column: 11, Offset: 10}},
+							token:   *newTokenLineSpan(1, 11, 10, 1, idl.TokenTypeIntegerDecimal, "2"),
+							val:     2,
+						},
+					},
+					astReservedRange{
+						astNode: astNode{idl.Location{Line: 1, Column: 21, Offset: 20}},
+						start: astValueLiteralInt{
+							astNode: astNode{idl.Location{Line: 1, Column: 15, Offset: 14}},
+							token:   *newTokenLineSpan(1, 15, 14, 1, idl.TokenTypeIntegerDecimal, "5"),
+							val:     5,
+						},
+						end: &astValueLiteralInt{
+							astNode: astNode{idl.Location{Line: 1, Column: 21, Offset: 20}},
+							token:   *newTokenLineSpan(1, 21, 20, 1, idl.TokenTypeIntegerDecimal, "9"),
+							val:     9,
+						},
+					},
+					astValueLiteralText{
+						astNode: astNode{idl.Location{Line: 1, Column: 28, Offset: 27}},
+						val:     *newTokenLineSpan(1, 28, 27, 3, idl.TokenTypeText, "foo"),
+					},
+				},
+			},
+		},
+		{
+			name:     "reserved without a UID or name",
+			input:    "reserved foo",
+			parser:   func(p *parserMicroglotTokens) node { return p.parseReserved() },
+			expected: (*astReserved)(nil),
+		},
 		{
 			name:   "import",
 			input:  "import \"foo\" as .",
@@ -126,6 +167,39 @@ func TestParser(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:   "annotation method scopes",
+			input:  "annotation foo (apimethod, sdkmethod) :bar @1",
+			parser: func(p *parserMicroglotTokens) node { return p.parseStatementAnnotation() },
+			expected: &astStatementAnnotation{
+				astNode:    astNode{idl.Location{Line: 1, Column: 45, Offset: 44}},
+				identifier: *newTokenLineSpan(1, 14, 13, 3, idl.TokenTypeIdentifier, "foo"),
+				annotationScopes: []astAnnotationScope{
+					astAnnotationScope{
+						astNode: astNode{idl.Location{Line: 1, Column: 25, Offset: 24}},
+						scope:   *newTokenLineSpan(1, 25, 24, 9, idl.TokenTypeIdentifier, "apimethod"),
+					},
+					astAnnotationScope{
+						astNode: astNode{idl.Location{Line: 1, Column: 36, Offset: 35}},
+						scope:   *newTokenLineSpan(1, 36, 35, 9, idl.TokenTypeIdentifier, "sdkmethod"),
+					},
+				},
+				typeSpecifier: astTypeSpecifier{
+					astNode:   astNode{idl.Location{Line: 1, Column: 42, Offset: 41}},
+					qualifier: nil,
+					typeName: astTypeName{
+						astNode:    astNode{idl.Location{Line: 1, Column: 42, Offset: 41}},
+						identifier: *newTokenLineSpan(1, 42, 41, 3, idl.TokenTypeIdentifier, "bar"),
+						parameters: nil,
+					},
+				},
+				uid: &astValueLiteralInt{
+					astNode: astNode{idl.Location{Line: 1, Column: 45, Offset: 44}},
+					token:   *newTokenLineSpan(1, 45, 44, 1, idl.TokenTypeIntegerDecimal, "1"),
+					val:     1,
+				},
+			},
+		},
 		{
 			name:   "const",
 			input:  "const foo :bar = 1 @1234 $(baz(2))\n//comment",
@@ -283,6 +357,46 @@ func TestParser(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:   "api streaming",
+			input:  "api foo { baz(stream :int) returns (stream :bool) }",
+			parser: func(p *parserMicroglotTokens) node { return p.parseStatementAPI() },
+			expected: &astStatementAPI{
+				astNode: astNode{idl.Location{Line: 1, Column: 51, Offset: 51}},
+				typeName: astTypeName{
+					astNode:    astNode{idl.Location{Line: 1, Column: 7, Offset: 6}},
+					identifier: *newTokenLineSpan(1, 7, 6, 3, idl.TokenTypeIdentifier, "foo"),
+				},
+				methods: []astAPIMethod{
+					astAPIMethod{
+						astNode:    astNode{idl.Location{Line: 1, Column: 49, Offset: 49}},
+						identifier: *newTokenLineSpan(1, 13, 12, 3, idl.TokenTypeIdentifier, "baz"),
+						methodInput: astAPIMethodInput{
+							astNode: astNode{idl.Location{Line: 1, Column: 26, Offset: 26}},
+							stream:  true,
+							typeSpecifier: astTypeSpecifier{
+								astNode: astNode{idl.Location{Line: 1, Column: 25, Offset: 24}},
+								typeName: astTypeName{
+									astNode:    astNode{idl.Location{Line: 1, Column: 25, Offset: 24}},
+									identifier: *newTokenLineSpan(1, 25, 24, 3, idl.TokenTypeIdentifier, "int"),
+								},
+							},
+						},
+						methodReturns: astAPIMethodReturns{
+							astNode: astNode{idl.Location{Line: 1, Column: 49, Offset: 49}},
+							stream:  true,
+							typeSpecifier: astTypeSpecifier{
+								astNode: astNode{idl.Location{Line: 1, Column: 48, Offset: 47}},
+								typeName: astTypeName{
+									astNode:    astNode{idl.Location{Line: 1, Column: 48, Offset: 47}},
+									identifier: *newTokenLineSpan(1, 48, 47, 4, idl.TokenTypeIdentifier, "bool"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name:   "sdk",
 			input:  "sdk foo { baz(x :int) returns (:bool) }",
@@ -488,7 +602,7 @@ func TestParser(t *testing.T) {
 		{
 			name:   "literal list (empty)",
 			input:  "[]",
-			parser: func(p *parserMicroglotTokens) node { return p.parseValueLiteralList() },
+			parser: func(p *parserMicroglotTokens) node { return p.parseValueLiteralListOrMap() },
 			expected: &astValueLiteralList{
 				astNode: astNode{idl.Location{Line: 1, Column: 2, Offset: 2}},
 				vals:    []astValue{},
@@ -497,7 +611,7 @@ func TestParser(t *testing.T) {
 		{
 			name:   "literal list (non-empty)",
 			input:  "[x]",
-			parser: func(p *parserMicroglotTokens) node { return p.parseValueLiteralList() },
+			parser: func(p *parserMicroglotTokens) node { return p.parseValueLiteralListOrMap() },
 			expected: &astValueLiteralList{
 				astNode: astNode{idl.Location{Line: 1, Column: 3, Offset: 3}},
 				vals: []astValue{
@@ -513,7 +627,7 @@ func TestParser(t *testing.T) {
 		{
 			name:   "literal list (non-empty, with trailing comma)",
 			input:  "[x,]",
-			parser: func(p *parserMicroglotTokens) node { return p.parseValueLiteralList() },
+			parser: func(p *parserMicroglotTokens) node { return p.parseValueLiteralListOrMap() },
 			expected: &astValueLiteralList{
 				astNode: astNode{idl.Location{Line: 1, Column: 4, Offset: 4}},
 				vals: []astValue{
@@ -558,6 +672,39 @@ func TestParser(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:   "literal map (empty)",
+			input:  "[:]",
+			parser: func(p *parserMicroglotTokens) node { return p.parseValueLiteralListOrMap() },
+			expected: &astValueLiteralMap{
+				astNode: astNode{idl.Location{Line: 1, Column: 3, Offset: 3}},
+				vals:    []astLiteralMapPair{},
+			},
+		},
+		{
+			name:   "literal map (non-empty)",
+			input:  "[x: 2]",
+			parser: func(p *parserMicroglotTokens) node { return p.parseValueLiteralListOrMap() },
+			expected: &astValueLiteralMap{
+				astNode: astNode{idl.Location{Line: 1, Column: 6, Offset: 6}},
+				vals: []astLiteralMapPair{
+					astLiteralMapPair{
+						astNode: astNode{idl.Location{Line: 1, Column: 5, Offset: 4}},
+						key: astValue{astValueIdentifier{
+							astNode: astNode{idl.Location{Line: 1, Column: 2, Offset: 1}},
+							components: []idl.Token{
+								*newTokenLineSpan(1, 2, 1, 1, idl.TokenTypeIdentifier, "x"),
+							},
+						}},
+						value: astValue{astValueLiteralInt{
+							astNode: astNode{idl.Location{Line: 1, Column: 5, Offset: 4}},
+							token:   *newTokenLineSpan(1, 5, 4, 1, idl.TokenTypeIntegerDecimal, "2"),
+							val:     2,
+						}},
+					},
+				},
+			},
+		},
 		{
 			name:   "type specifier with qualifier",
 			input:  ":foo.bar",
@@ -608,3 +755,81 @@ func TestParser(t *testing.T) {
 		})
 	}
 }
+
+func TestParseModuleRecovery(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name       string
+		input      string
+		lines      []int32
+		statements int
+	}{
+		{
+			name:       "valid module",
+			input:      "syntax = \"mglot0\"\nmodule = @1\nstruct Foo {\n  bar :Text\n}\n",
+			lines:      nil,
+			statements: 2,
+		},
+		{
+			name:       "error in every statement",
+			input:      "syntax = \"mglot0\"\nmodule = @1\nstruct Foo {\n  bar :Text =\n}\nenum Baz {\n  = @1\n}\nconst Qux :Int32 = 1\n",
+			lines:      []int32{4, 6},
+			statements: 4,
+		},
+		{
+			name:       "errors in several fields of a struct",
+			input:      "syntax = \"mglot0\"\nmodule = @1\nstruct Foo {\n  bar :Text = = @1\n  baz :Int32 = ]\n  qux :Bool @3\n  quux (:Text) @4\n}\nstruct Bar {}\n",
+			lines:      []int32{4, 5, 7},
+			statements: 3,
+		},
+		{
+			name:       "missing closing brace",
+			input:      "syntax = \"mglot0\"\nstruct Foo {\n  bar :Text = =\nstruct Bar {}\n",
+			lines:      []int32{3},
+			statements: 1,
+		},
+		{
+			name:       "unexpected tokens between statements",
+			input:      "syntax = \"mglot0\"\nlemon\nstruct Foo {}\n} }\nstruct Bar {}\n",
+			lines:      []int32{1, 3},
+			statements: 2,
+		},
+		{
+			name:       "statement keywords inside skipped brackets",
+			input:      "syntax = \"mglot0\"\nannotation Foo(lemon (struct, enum)) :Text\nstruct Bar {}\n",
+			lines:      []int32{2},
+			statements: 1,
+		},
+		{
+			name:       "missing syntax statement",
+			input:      "module = @1\nstruct Foo {\n",
+			lines:      []int32{0, 2},
+			statements: 1,
+		},
+	}
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			input := fs.NewFileString("/test", testCase.input, idl.FileKindMicroglot)
+			rep := exc.NewReporter(nil)
+			lexer := NewLexerMicroglot(rep)
+			lexerFile, err := lexer.Lex(ctx, input)
+			require.Nil(t, err)
+			parser := NewParserMicroglot(rep)
+			p, err := parser.PrepareParse(ctx, lexerFile)
+			require.Nil(t, err)
+
+			module := p.ParseModule()
+			require.NotNil(t, module)
+			require.Len(t, module.statements, testCase.statements)
+			require.Equal(t, len(testCase.lines) > 0, p.Failed())
+			var lines []int32
+			for _, reported := range rep.Reported() {
+				lines = append(lines, reported.Location().Line)
+			}
+			require.Equal(t, testCase.lines, lines, rep.Reported())
+		})
+	}
+}
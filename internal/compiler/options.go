@@ -0,0 +1,462 @@
+// © 2023 Microglot LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+
+	"gopkg.microglot.org/mglotc/internal/exc"
+	"gopkg.microglot.org/mglotc/internal/fs"
+	"gopkg.microglot.org/mglotc/internal/idl"
+	"gopkg.microglot.org/mglotc/internal/proto"
+)
+
+// interpretOptions converts the values of the custom options in modules that
+// were compiled from protobuf sources to the types of the annotations that the
+// options apply. The values are converted from the protobuf text format before
+// those types are known, so integers are as wide as possible, enum values are
+// identifiers, and repeated fields may be set more than once. An option that
+// can't be converted is reported and removed so that the checker doesn't
+// report it again.
+func interpretOptions(image *idl.Image, reporter exc.Reporter) {
+	interpreter := optionInterpreter{
+		image:    image,
+		reporter: reporter,
+	}
+	for _, module := range image.Modules {
+		if fs.KindOf(module.URI) != idl.FileKindProtobuf {
+			continue
+		}
+		interpreter.module = module
+		module.AnnotationApplications = interpreter.interpret(module.AnnotationApplications)
+		for _, struct_ := range module.Structs {
+			struct_.AnnotationApplications = interpreter.interpret(struct_.AnnotationApplications)
+			for _, field := range struct_.Fields {
+				field.AnnotationApplications = interpreter.interpret(field.AnnotationApplications)
+			}
+			for _, union := range struct_.Unions {
+				union.AnnotationApplications = interpreter.interpret(union.AnnotationApplications)
+			}
+		}
+		for _, enum := range module.Enums {
+			enum.AnnotationApplications = interpreter.interpret(enum.AnnotationApplications)
+			for _, enumerant := range enum.Enumerants {
+				enumerant.AnnotationApplications = interpreter.interpret(enumerant.AnnotationApplications)
+			}
+		}
+		for _, api := range module.APIs {
+			api.AnnotationApplications = interpreter.interpret(api.AnnotationApplications)
+			for _, apiMethod := range api.Methods {
+				apiMethod.AnnotationApplications = interpreter.interpret(apiMethod.AnnotationApplications)
+			}
+		}
+	}
+}
+
+type optionInterpreter struct {
+	image    *idl.Image
+	reporter exc.Reporter
+	module   *proto.Module
+}
+
+// optionProblem is a value that can't be converted to the type of its option.
+type optionProblem struct {
+	location *proto.SourceLocation
+	message  string
+}
+
+func newOptionProblem(value *proto.Value, format string, args ...any) *optionProblem {
+	return &optionProblem{
+		location: value.Location,
+		message:  fmt.Sprintf(format, args...),
+	}
+}
+
+func (i *optionInterpreter) interpret(annotationApplications []*proto.AnnotationApplication) []*proto.AnnotationApplication {
+	interpreted := make([]*proto.AnnotationApplication, 0, len(annotationApplications))
+	for _, annotationApplication := range annotationApplications {
+		resolved, ok := annotationApplication.Annotation.Reference.(*proto.TypeSpecifier_Resolved)
+		// moduleUID 2 is for Protobuf annotations, which are already typed.
+		if !ok || resolved.Resolved.Reference.ModuleUID == idl.ProtobufModuleUID {
+			interpreted = append(interpreted, annotationApplication)
+			continue
+		}
+		kind, declaration := i.image.Lookup(resolved.Resolved.Reference)
+		if kind != idl.TypeKindAnnotation {
+			// The checker reports this.
+			interpreted = append(interpreted, annotationApplication)
+			continue
+		}
+		annotation := declaration.(*proto.Annotation)
+		value, problem := i.convert(annotationApplication.Value, annotation.Type)
+		if problem != nil {
+			location := problem.location
+			if location == nil {
+				location = annotationApplication.Location
+			}
+			_ = i.reporter.Report(exc.New(sourceLocation(i.module.URI, location), exc.CodeInvalidOption, fmt.Sprintf("option (%s): %s", i.optionName(resolved.Resolved.Reference, annotation), problem.message)))
+			continue
+		}
+		annotationApplication.Value = value
+		interpreted = append(interpreted, annotationApplication)
+	}
+	return interpreted
+}
+
+// optionName returns the full protobuf name of the extension that an
+// annotation was converted from.
+func (i *optionInterpreter) optionName(reference *proto.TypeReference, annotation *proto.Annotation) string {
+	for _, module := range i.image.Modules {
+		if module.UID == reference.ModuleUID && module.ProtobufPackage != "" {
+			return module.ProtobufPackage + "." + annotation.Name
+		}
+	}
+	return annotation.Name
+}
+
+// convert returns the value converted to the given type.
+func (i *optionInterpreter) convert(value *proto.Value, ts *proto.TypeSpecifier) (*proto.Value, *optionProblem) {
+	resolved, ok := ts.Reference.(*proto.TypeSpecifier_Resolved)
+	if !ok {
+		// The checker reports this.
+		return value, nil
+	}
+	kind, declaration := i.image.Lookup(resolved.Resolved.Reference)
+	if kind == idl.TypeKindVirtual {
+		switch declaration.(*proto.Struct).Name.Name {
+		case "List":
+			elements := flattenOptionList(value)
+			converted := make([]*proto.Value, 0, len(elements))
+			for _, element := range elements {
+				element, problem := i.convert(element, resolved.Resolved.Parameters[0])
+				if problem != nil {
+					return nil, problem
+				}
+				converted = append(converted, element)
+			}
+			return &proto.Value{
+				Kind: &proto.Value_List{
+					List: &proto.ValueList{
+						Elements: converted,
+					},
+				},
+				Location: value.Location,
+			}, nil
+		case "Presence":
+			return i.convert(value, resolved.Resolved.Parameters[0])
+		default:
+			return nil, newOptionProblem(value, "%s fields aren't supported in options", declaration.(*proto.Struct).Name.Name)
+		}
+	}
+	if list, ok := value.Kind.(*proto.Value_List); ok {
+		if len(list.List.Elements) > 1 {
+			value = list.List.Elements[1]
+		}
+		return nil, newOptionProblem(value, "a value that isn't repeated is set more than once")
+	}
+	switch kind {
+	case idl.TypeKindPrimitive:
+		return convertOptionPrimitive(value, declaration.(*proto.Struct).Name.Name)
+	case idl.TypeKindData:
+		if text, ok := value.Kind.(*proto.Value_Text); ok {
+			return &proto.Value{
+				Kind: &proto.Value_Data{
+					Data: &proto.ValueData{
+						Value:  []byte(text.Text.Value),
+						Source: text.Text.Source,
+					},
+				},
+				Location: value.Location,
+			}, nil
+		}
+		return nil, newOptionProblem(value, "expecting Data, found %s", describeOptionValue(value))
+	case idl.TypeKindEnum:
+		return convertOptionEnum(value, declaration.(*proto.Enum))
+	case idl.TypeKindStruct:
+		return i.convertStruct(value, declaration.(*proto.Struct))
+	}
+	// The checker reports annotations of any other type.
+	return value, nil
+}
+
+func flattenOptionList(value *proto.Value) []*proto.Value {
+	list, ok := value.Kind.(*proto.Value_List)
+	if !ok {
+		return []*proto.Value{value}
+	}
+	var elements []*proto.Value
+	for _, element := range list.List.Elements {
+		elements = append(elements, flattenOptionList(element)...)
+	}
+	return elements
+}
+
+// convertStruct converts the fields of a message value. A repeated field that
+// is set more than once collects all of the values, and a message field that
+// is set more than once merges them.
+func (i *optionInterpreter) convertStruct(value *proto.Value, struct_ *proto.Struct) (*proto.Value, *optionProblem) {
+	valueStruct, ok := value.Kind.(*proto.Value_Struct)
+	if !ok {
+		return nil, newOptionProblem(value, "expecting %s, found %s", struct_.Name.Name, describeOptionValue(value))
+	}
+	var names []string
+	set := make(map[string][]*proto.Value)
+	for _, valueStructField := range valueStruct.Struct.Fields {
+		if _, ok := set[valueStructField.Name]; !ok {
+			names = append(names, valueStructField.Name)
+		}
+		set[valueStructField.Name] = append(set[valueStructField.Name], valueStructField.Value)
+	}
+
+	fields := make([]*proto.ValueStructField, 0, len(names))
+	for _, name := range names {
+		var field *proto.Field
+		for _, candidate := range struct_.Fields {
+			if candidate.Name == name {
+				field = candidate
+				break
+			}
+		}
+		values := set[name]
+		if field == nil {
+			return nil, newOptionProblem(values[0], "%s has no field named %s", struct_.Name.Name, name)
+		}
+		fieldValue := values[0]
+		if len(values) > 1 {
+			fieldValue = i.mergeFieldValues(field, values)
+			if fieldValue == nil {
+				return nil, newOptionProblem(values[1], "field %s of %s is set more than once", name, struct_.Name.Name)
+			}
+		}
+		converted, problem := i.convert(fieldValue, field.Type)
+		if problem != nil {
+			return nil, problem
+		}
+		fields = append(fields, &proto.ValueStructField{
+			Name:  name,
+			Value: converted,
+		})
+	}
+	return &proto.Value{
+		Kind: &proto.Value_Struct{
+			Struct: &proto.ValueStruct{
+				Fields: fields,
+			},
+		},
+		Location: value.Location,
+	}, nil
+}
+
+// mergeFieldValues combines the values of a field that is set more than once,
+// or returns nil if the field can only be set once.
+func (i *optionInterpreter) mergeFieldValues(field *proto.Field, values []*proto.Value) *proto.Value {
+	if resolved, ok := field.Type.Reference.(*proto.TypeSpecifier_Resolved); ok {
+		kind, declaration := i.image.Lookup(resolved.Resolved.Reference)
+		if kind == idl.TypeKindVirtual && declaration.(*proto.Struct).Name.Name == "List" {
+			return &proto.Value{
+				Kind: &proto.Value_List{
+					List: &proto.ValueList{
+						Elements: values,
+					},
+				},
+				Location: values[0].Location,
+			}
+		}
+	}
+	var fields []*proto.ValueStructField
+	for _, value := range values {
+		valueStruct, ok := value.Kind.(*proto.Value_Struct)
+		if !ok {
+			return nil
+		}
+		fields = append(fields, valueStruct.Struct.Fields...)
+	}
+	return &proto.Value{
+		Kind: &proto.Value_Struct{
+			Struct: &proto.ValueStruct{
+				Fields: fields,
+			},
+		},
+		Location: values[0].Location,
+	}
+}
+
+// convertOptionEnum converts an enum value, which is given either by name or
+// by number.
+func convertOptionEnum(value *proto.Value, enum *proto.Enum) (*proto.Value, *optionProblem) {
+	var found *proto.Enumerant
+	switch kind := value.Kind.(type) {
+	case *proto.Value_Identifier:
+		name := strings.Join(kind.Identifier.Names, ".")
+		for _, enumerant := range enum.Enumerants {
+			if enumerant.Name == name {
+				found = enumerant
+				break
+			}
+		}
+		if found == nil {
+			return nil, newOptionProblem(value, "enum %s has no value named %s", enum.Name, name)
+		}
+	default:
+		var i big.Int
+		if !unfoldInteger(value, &i) {
+			return nil, newOptionProblem(value, "expecting %s, found %s", enum.Name, describeOptionValue(value))
+		}
+		if !i.IsInt64() || i.Int64() < math.MinInt32 || i.Int64() > math.MaxInt32 {
+			return nil, newOptionProblem(value, "%s is out of range for enum %s", i.String(), enum.Name)
+		}
+		// Enumerant UIDs hold negative values sign-extended.
+		uid := uint64(i.Int64())
+		for _, enumerant := range enum.Enumerants {
+			if enumerant.Reference.AttributeUID == uid {
+				found = enumerant
+				break
+			}
+		}
+		if found == nil {
+			return nil, newOptionProblem(value, "enum %s has no value %s", enum.Name, i.String())
+		}
+	}
+	return &proto.Value{
+		Kind: &proto.Value_Enumerant{
+			Enumerant: &proto.AttributeReference{
+				ModuleUID:    found.Reference.ModuleUID,
+				TypeUID:      found.Reference.TypeUID,
+				AttributeUID: found.Reference.AttributeUID,
+			},
+		},
+		Location: value.Location,
+	}, nil
+}
+
+// optionIntegerRanges are the bounds of the integer types.
+var optionIntegerRanges = map[string][2]*big.Int{
+	"Int8":   {big.NewInt(math.MinInt8), big.NewInt(math.MaxInt8)},
+	"Int16":  {big.NewInt(math.MinInt16), big.NewInt(math.MaxInt16)},
+	"Int32":  {big.NewInt(math.MinInt32), big.NewInt(math.MaxInt32)},
+	"Int64":  {big.NewInt(math.MinInt64), big.NewInt(math.MaxInt64)},
+	"UInt8":  {big.NewInt(0), big.NewInt(math.MaxUint8)},
+	"UInt16": {big.NewInt(0), big.NewInt(math.MaxUint16)},
+	"UInt32": {big.NewInt(0), big.NewInt(math.MaxUint32)},
+	"UInt64": {big.NewInt(0), new(big.Int).SetUint64(math.MaxUint64)},
+}
+
+// convertOptionPrimitive converts a value to the primitive type with the given
+// name. Integers are range checked, and may also be given for floats.
+func convertOptionPrimitive(value *proto.Value, name string) (*proto.Value, *optionProblem) {
+	switch name {
+	case "Bool":
+		if _, ok := value.Kind.(*proto.Value_Bool); ok {
+			return value, nil
+		}
+	case "Text":
+		if _, ok := value.Kind.(*proto.Value_Text); ok {
+			return value, nil
+		}
+	case "Float32", "Float64":
+		var f float64
+		var i big.Int
+		if float, ok := value.Kind.(*proto.Value_Float64); ok {
+			f = float.Float64.Value
+		} else if identifier, ok := value.Kind.(*proto.Value_Identifier); ok && len(identifier.Identifier.Names) == 1 {
+			switch strings.ToLower(identifier.Identifier.Names[0]) {
+			case "inf", "infinity":
+				f = math.Inf(1)
+			case "nan":
+				f = math.NaN()
+			default:
+				return nil, newOptionProblem(value, "expecting %s, found %s", name, describeOptionValue(value))
+			}
+		} else if unfoldInteger(value, &i) {
+			f, _ = new(big.Float).SetInt(&i).Float64()
+		} else {
+			break
+		}
+		source := fmt.Sprint(f)
+		if name == "Float32" {
+			return &proto.Value{
+				Kind: &proto.Value_Float32{
+					Float32: &proto.ValueFloat32{
+						Value:  float32(f),
+						Source: source,
+					},
+				},
+				Location: value.Location,
+			}, nil
+		}
+		return &proto.Value{
+			Kind: &proto.Value_Float64{
+				Float64: &proto.ValueFloat64{
+					Value:  f,
+					Source: source,
+				},
+			},
+			Location: value.Location,
+		}, nil
+	default:
+		bounds, ok := optionIntegerRanges[name]
+		var i big.Int
+		if !ok || !unfoldInteger(value, &i) {
+			break
+		}
+		if i.Cmp(bounds[0]) < 0 || i.Cmp(bounds[1]) > 0 {
+			return nil, newOptionProblem(value, "%s is out of range for %s", i.String(), name)
+		}
+		return integerValue(name, &i, value.Location), nil
+	}
+	return nil, newOptionProblem(value, "expecting %s, found %s", name, describeOptionValue(value))
+}
+
+// integerValue returns an integer, which must be in range, as a value of the
+// integer type with the given name.
+func integerValue(name string, i *big.Int, location *proto.SourceLocation) *proto.Value {
+	value := &proto.Value{Location: location}
+	source := i.String()
+	switch name {
+	case "Int8":
+		value.Kind = &proto.Value_Int8{Int8: &proto.ValueInt8{Value: int32(i.Int64()), Source: source}}
+	case "Int16":
+		value.Kind = &proto.Value_Int16{Int16: &proto.ValueInt16{Value: int32(i.Int64()), Source: source}}
+	case "Int32":
+		value.Kind = &proto.Value_Int32{Int32: &proto.ValueInt32{Value: int32(i.Int64()), Source: source}}
+	case "Int64":
+		value.Kind = &proto.Value_Int64{Int64: &proto.ValueInt64{Value: i.Int64(), Source: source}}
+	case "UInt8":
+		value.Kind = &proto.Value_UInt8{UInt8: &proto.ValueUInt8{Value: uint32(i.Uint64()), Source: source}}
+	case "UInt16":
+		value.Kind = &proto.Value_UInt16{UInt16: &proto.ValueUInt16{Value: uint32(i.Uint64()), Source: source}}
+	case "UInt32":
+		value.Kind = &proto.Value_UInt32{UInt32: &proto.ValueUInt32{Value: uint32(i.Uint64()), Source: source}}
+	case "UInt64":
+		value.Kind = &proto.Value_UInt64{UInt64: &proto.ValueUInt64{Value: i.Uint64(), Source: source}}
+	}
+	return value
+}
+
+// describeOptionValue names the kind of a value as it was written.
+func describeOptionValue(value *proto.Value) string {
+	switch kind := value.Kind.(type) {
+	case *proto.Value_Bool:
+		return "boolean"
+	case *proto.Value_Text:
+		return "text"
+	case *proto.Value_Int64, *proto.Value_UInt64:
+		return "integer"
+	case *proto.Value_Float64:
+		return "float"
+	case *proto.Value_Identifier:
+		return fmt.Sprintf("identifier %s", strings.Join(kind.Identifier.Names, "."))
+	case *proto.Value_List:
+		return "list"
+	case *proto.Value_Map:
+		return "map"
+	case *proto.Value_Struct:
+		return "message"
+	}
+	return fmt.Sprintf("%T", value.Kind)
+}
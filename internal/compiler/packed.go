@@ -0,0 +1,59 @@
+// © 2023 Microglot LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"slices"
+
+	"gopkg.microglot.org/mglotc/internal/fs"
+	"gopkg.microglot.org/mglotc/internal/idl"
+	"gopkg.microglot.org/mglotc/internal/proto"
+)
+
+// resolvePacked removes $(Protobuf.Packed()) from the repeated fields of
+// modules that were compiled from protobuf sources whose values turn out not
+// to be packable once they are linked. Fields that name a message or an enum
+// have no type until then, so they are given the annotation as if they were
+// enums.
+func resolvePacked(image *idl.Image) {
+	for _, module := range image.Modules {
+		if fs.KindOf(module.URI) != idl.FileKindProtobuf {
+			continue
+		}
+		fields := make([]*proto.Field, 0, len(module.Extensions))
+		for _, extension := range module.Extensions {
+			fields = append(fields, extension.Field)
+		}
+		for _, struct_ := range module.Structs {
+			fields = append(fields, struct_.Fields...)
+			for _, extension := range struct_.Extensions {
+				fields = append(fields, extension.Field)
+			}
+		}
+		for _, field := range fields {
+			if idl.GetProtobufAnnotation(field.AnnotationApplications, "Packed") == nil || isPackableList(image, field.Type) {
+				continue
+			}
+			field.AnnotationApplications = slices.DeleteFunc(field.AnnotationApplications, func(annotationApplication *proto.AnnotationApplication) bool {
+				return idl.GetProtobufAnnotation([]*proto.AnnotationApplication{annotationApplication}, "Packed") != nil
+			})
+		}
+	}
+}
+
+// isPackableList reports whether a type is a list whose elements are
+// primitives or enums.
+func isPackableList(image *idl.Image, typeSpecifier *proto.TypeSpecifier) bool {
+	resolved, ok := typeSpecifier.Reference.(*proto.TypeSpecifier_Resolved)
+	if !ok || idl.BuiltinTypeName(typeSpecifier) != "List" || len(resolved.Resolved.Parameters) < 1 {
+		return false
+	}
+	element, ok := resolved.Resolved.Parameters[0].Reference.(*proto.TypeSpecifier_Resolved)
+	if !ok {
+		return false
+	}
+	kind, _ := image.Lookup(element.Resolved.Reference)
+	return kind == idl.TypeKindPrimitive || kind == idl.TypeKindEnum
+}
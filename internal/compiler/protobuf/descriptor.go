@@ -8,10 +8,12 @@ import (
 	"bytes"
 	"crypto/sha256"
 	"encoding/binary"
-	"errors"
 	"fmt"
+	"math"
+	"slices"
 	"strconv"
 
+	"github.com/bufbuild/protocompile/parser"
 	"google.golang.org/protobuf/types/descriptorpb"
 
 	"gopkg.microglot.org/mglotc/internal/idl"
@@ -73,10 +75,21 @@ func appendProtobufAnnotationBoolean(as []*proto.AnnotationApplication, name str
 	})
 }
 
+// promotion records that a nested type was promoted to the top level of the
+// module under another name.
+type promotion struct {
+	from string
+	to   string
+}
+
 // $(Protobuf.NestedTypeInfo()) is encoded as a Protobuf.NestedTypes struct
-func computeNestedTypeInfo(promoted map[string]string) *proto.Value {
+//
+// The elements are in the order that the nested types are declared in, which
+// is the order that they are converted back to protobuf in.
+func computeNestedTypeInfo(promoted []promotion) *proto.Value {
 	elements := make([]*proto.Value, 0)
-	for key, value := range promoted {
+	for _, promotion := range promoted {
+		key, value := promotion.from, promotion.to
 		elements = append(elements, &proto.Value{
 			Kind: &proto.Value_Struct{
 				Struct: &proto.ValueStruct{
@@ -146,8 +159,10 @@ func nameCollides(name string, structs *[]*proto.Struct, enums *[]*proto.Enum) b
 	return false
 }
 
-func (c *fileDescriptorConverter) promoteNested(structs *[]*proto.Struct, enums *[]*proto.Enum, prefix string, descriptor *descriptorpb.DescriptorProto) (map[string]string, error) {
-	var promotions map[string]string
+func (c *fileDescriptorConverter) promoteNested(structs *[]*proto.Struct, enums *[]*proto.Enum, prefix string, descriptor *descriptorpb.DescriptorProto) ([]promotion, error) {
+	var promotions []promotion
+	c.p.PushFieldNumber( /* NestedType */ 3)
+	c.p.PushIndex()
 	for _, descriptorProto := range descriptor.NestedType {
 		// recur
 		promoted, err := c.promoteNested(structs, enums, prefix+*descriptorProto.Name+"_", descriptorProto)
@@ -173,11 +188,13 @@ func (c *fileDescriptorConverter) promoteNested(structs *[]*proto.Struct, enums
 		}
 		*structs = append(*structs, struct_)
 
-		if promotions == nil {
-			promotions = make(map[string]string)
-		}
-		promotions[*descriptorProto.Name] = struct_.Name.Name
+		promotions = append(promotions, promotion{from: *descriptorProto.Name, to: struct_.Name.Name})
+		c.p.IncrementIndex()
 	}
+	c.p.PopIndex()
+	c.p.PopFieldNumber()
+	c.p.PushFieldNumber( /* EnumType */ 4)
+	c.p.PushIndex()
 	for _, enumDescriptorProto := range descriptor.EnumType {
 		enum, err := c.fromEnumDescriptorProto(enumDescriptorProto)
 		if err != nil {
@@ -193,16 +210,19 @@ func (c *fileDescriptorConverter) promoteNested(structs *[]*proto.Struct, enums
 		}
 		*enums = append(*enums, enum)
 
-		if promotions == nil {
-			promotions = make(map[string]string)
-		}
-		promotions[*enumDescriptorProto.Name] = enum.Name
+		promotions = append(promotions, promotion{from: *enumDescriptorProto.Name, to: enum.Name})
+		c.p.IncrementIndex()
 	}
+	c.p.PopIndex()
+	c.p.PopFieldNumber()
 	return promotions, nil
 }
 
 type fileDescriptorConverter struct {
 	fileDescriptor *descriptorpb.FileDescriptorProto
+	// result is the parse result that the file descriptor came from, if it
+	// was parsed from source, which holds the values of custom options.
+	result parser.Result
 
 	p *idl.PathState
 }
@@ -214,9 +234,21 @@ func FromFileDescriptorProto(fileDescriptor *descriptorpb.FileDescriptorProto) (
 	return converter.convert()
 }
 
+// FromParseResult converts a file that was parsed from source. Unlike
+// FromFileDescriptorProto, this also converts its custom options.
+func FromParseResult(result parser.Result) (*proto.Module, error) {
+	converter := fileDescriptorConverter{
+		fileDescriptor: result.FileDescriptorProto(),
+		result:         result,
+	}
+	return converter.convert()
+}
+
 func (c *fileDescriptorConverter) convert() (*proto.Module, error) {
+	c.p = &idl.PathState{}
+
 	var imports []*proto.Import
-	for _, import_ := range c.fileDescriptor.Dependency {
+	for index, import_ := range c.fileDescriptor.Dependency {
 		imports = append(imports, &proto.Import{
 			// ModuleUID:
 			// ImportedUID:
@@ -226,16 +258,21 @@ func (c *fileDescriptorConverter) convert() (*proto.Module, error) {
 			// Alias:
 
 			// CommentBlock:
+			Location: c.fromSourceLocation( /* Dependency */ 3, int32(index)),
+			IsPublic: slices.Contains(c.fileDescriptor.PublicDependency, int32(index)),
+			IsWeak:   slices.Contains(c.fileDescriptor.WeakDependency, int32(index)),
 		})
 	}
 
-	c.p = &idl.PathState{}
-
 	var structs []*proto.Struct
+	c.p.PushFieldNumber( /* EnumType */ 5)
+	c.p.PushIndex()
 	enums, err := mapFrom(c.p, c.fileDescriptor.EnumType, c.fromEnumDescriptorProto)
 	if err != nil {
 		return nil, err
 	}
+	c.p.PopIndex()
+	c.p.PopFieldNumber()
 	c.p.PushFieldNumber( /* MessageType */ 4)
 	c.p.PushIndex()
 	for _, descriptorProto := range c.fileDescriptor.MessageType {
@@ -278,18 +315,49 @@ func (c *fileDescriptorConverter) convert() (*proto.Module, error) {
 		annotationApplications = appendProtobufAnnotationString(annotationApplications, "Package", protobufPackage)
 	}
 
+	// Modules are converted back to proto3 unless they record otherwise. An
+	// empty syntax is proto2, and files that use editions also record which.
+	if syntax := c.fileDescriptor.GetSyntax(); syntax != "proto3" {
+		if syntax == "" {
+			syntax = "proto2"
+		}
+		annotationApplications = appendProtobufAnnotationString(annotationApplications, "Syntax", syntax)
+		if syntax == "editions" {
+			annotationApplications = appendProtobufAnnotationString(annotationApplications, "Edition", idl.EditionName(c.fileDescriptor.GetEdition()))
+		}
+	}
+
+	c.p.PushFieldNumber( /* Service */ 6)
+	c.p.PushIndex()
 	apis, err := mapFrom(c.p, c.fileDescriptor.Service, c.fromServiceDescriptorProto)
 	if err != nil {
 		return nil, err
 	}
+	c.p.PopIndex()
+	c.p.PopFieldNumber()
 
 	if c.fileDescriptor.Options != nil {
-		if c.fileDescriptor.Options.GoPackage != nil {
-			annotationApplications = appendProtobufAnnotationString(annotationApplications, "FileOptionsGoPackage", *c.fileDescriptor.Options.GoPackage)
+		options, err := c.fromOptions(c.fileDescriptor.Options, c.fileDescriptor.Options.UninterpretedOption)
+		if err != nil {
+			return nil, err
 		}
-		// TODO 2023.12.30: ... convert remaining Options
+		annotationApplications = append(annotationApplications, options...)
+	}
+
+	annotations, err := c.fromExtensions()
+	if err != nil {
+		return nil, err
 	}
 
+	c.p.PushFieldNumber( /* Extension */ 7)
+	c.p.PushIndex()
+	extensions, err := c.fromExtensionDescriptors(c.fileDescriptor.Extension, false)
+	if err != nil {
+		return nil, err
+	}
+	c.p.PopIndex()
+	c.p.PopFieldNumber()
+
 	return &proto.Module{
 		URI:                    *c.fileDescriptor.Name,
 		UID:                    moduleUID,
@@ -301,14 +369,19 @@ func (c *fileDescriptorConverter) convert() (*proto.Module, error) {
 		APIs:                   apis,
 		// SDKs
 		// Constants
-		// Annotations
+		Annotations: annotations,
 		// DotImports
+		Extensions: extensions,
 	}, nil
 }
 
 func (c *fileDescriptorConverter) fromDescriptorProto(descriptor *descriptorpb.DescriptorProto) (*proto.Struct, error) {
 	var unions []*proto.Union
 	for index, oneofDescriptor := range descriptor.OneofDecl {
+		annotationApplications, err := c.fromOptions(oneofDescriptor.GetOptions(), oneofDescriptor.GetOptions().GetUninterpretedOption())
+		if err != nil {
+			return nil, err
+		}
 		isSynthetic := false
 		for _, fieldDescriptor := range descriptor.Field {
 			if fieldDescriptor.Proto3Optional != nil && *fieldDescriptor.Proto3Optional {
@@ -326,7 +399,8 @@ func (c *fileDescriptorConverter) fromDescriptorProto(descriptor *descriptorpb.D
 				},
 				Name: *oneofDescriptor.Name,
 				// CommentBlock:
-				// AnnotationApplications:
+				AnnotationApplications: annotationApplications,
+				Location:               c.fromSourceLocation( /* OneofDecl */ 8, int32(index)),
 			})
 		}
 	}
@@ -340,12 +414,24 @@ func (c *fileDescriptorConverter) fromDescriptorProto(descriptor *descriptorpb.D
 	c.p.PopIndex()
 	c.p.PopFieldNumber()
 
+	c.p.PushFieldNumber( /* Extension */ 6)
+	c.p.PushIndex()
+	extensions, err := c.fromExtensionDescriptors(descriptor.Extension, true)
+	if err != nil {
+		return nil, err
+	}
+	c.p.PopIndex()
+	c.p.PopFieldNumber()
+
 	isSynthetic := false
 	if descriptor.Options != nil && descriptor.Options.MapEntry != nil && *descriptor.Options.MapEntry {
 		isSynthetic = true
 	}
 
-	// TODO 2023.10.10: convert other Options
+	annotationApplications, err := c.fromOptions(descriptor.GetOptions(), descriptor.GetOptions().GetUninterpretedOption())
+	if err != nil {
+		return nil, err
+	}
 
 	return &proto.Struct{
 		Reference: &proto.TypeReference{
@@ -356,59 +442,21 @@ func (c *fileDescriptorConverter) fromDescriptorProto(descriptor *descriptorpb.D
 			Name:       *descriptor.Name,
 			Parameters: nil,
 		},
-		Fields:      fields,
-		Unions:      unions,
-		IsSynthetic: isSynthetic,
-		// Reserved:
-		CommentBlock: c.fromSourceCodeInfo(),
-		// AnnotationsApplications:
+		Fields:                 fields,
+		Unions:                 unions,
+		IsSynthetic:            isSynthetic,
+		Reserved:               fromMessageReservedRanges(descriptor.ReservedRange),
+		ReservedNames:          descriptor.ReservedName,
+		ExtensionRanges:        c.fromExtensionRanges(descriptor.ExtensionRange),
+		Extensions:             extensions,
+		CommentBlock:           c.fromSourceCodeInfo(),
+		AnnotationApplications: annotationApplications,
+		Location:               c.fromSourceLocation(),
 	}, nil
 }
 
 func (c *fileDescriptorConverter) fromFieldDescriptorProto(fieldDescriptor *descriptorpb.FieldDescriptorProto) (*proto.Field, error) {
-	typeName := ""
-	if fieldDescriptor.Type == nil || *fieldDescriptor.Type == descriptorpb.FieldDescriptorProto_TYPE_GROUP || *fieldDescriptor.Type == descriptorpb.FieldDescriptorProto_TYPE_MESSAGE || *fieldDescriptor.Type == descriptorpb.FieldDescriptorProto_TYPE_ENUM {
-		typeName = *fieldDescriptor.TypeName
-	} else {
-		switch *fieldDescriptor.Type {
-		case descriptorpb.FieldDescriptorProto_TYPE_DOUBLE:
-			typeName = "Float64"
-		case descriptorpb.FieldDescriptorProto_TYPE_FLOAT:
-			typeName = "Float32"
-		case descriptorpb.FieldDescriptorProto_TYPE_INT64:
-			typeName = "Int64"
-		case descriptorpb.FieldDescriptorProto_TYPE_UINT64:
-			typeName = "UInt64"
-		case descriptorpb.FieldDescriptorProto_TYPE_INT32:
-			typeName = "Int32"
-		case descriptorpb.FieldDescriptorProto_TYPE_FIXED64:
-			// TODO 2023.10.10: annotate with $(Protobuf.FieldType())
-			typeName = "UInt64"
-		case descriptorpb.FieldDescriptorProto_TYPE_FIXED32:
-			// TODO 2023.10.10: annotate with $(Protobuf.FieldType())
-			typeName = "UInt32"
-		case descriptorpb.FieldDescriptorProto_TYPE_BOOL:
-			typeName = "Bool"
-		case descriptorpb.FieldDescriptorProto_TYPE_STRING:
-			typeName = "Text"
-		case descriptorpb.FieldDescriptorProto_TYPE_BYTES:
-			typeName = "Data"
-		case descriptorpb.FieldDescriptorProto_TYPE_UINT32:
-			typeName = "UInt32"
-		case descriptorpb.FieldDescriptorProto_TYPE_SFIXED32:
-			// TODO 2023.10.10: annotate with $(Protobuf.FieldType())
-			typeName = "Int32"
-		case descriptorpb.FieldDescriptorProto_TYPE_SFIXED64:
-			// TODO 2023.10.10: annotate with $(Protobuf.FieldType())
-			typeName = "Int64"
-		case descriptorpb.FieldDescriptorProto_TYPE_SINT32:
-			// TODO 2023.10.10: annotate with $(Protobuf.FieldType())
-			typeName = "Int32"
-		case descriptorpb.FieldDescriptorProto_TYPE_SINT64:
-			// TODO 2023.10.10: annotate with $(Protobuf.FieldType())
-			typeName = "Int64"
-		}
-	}
+	typeName := fieldTypeName(fieldDescriptor)
 
 	// Default values are a proto2 feature.
 	// In the fieldDescriptor, they are *string. It's not really clear if/where/when
@@ -528,8 +576,159 @@ func (c *fileDescriptorConverter) fromFieldDescriptorProto(fieldDescriptor *desc
 		}
 	}
 
-	// TODO 2023.10.10: convert Options
+	typeSpecifier, err := c.fromFieldType(fieldDescriptor, typeName)
+	if err != nil {
+		return nil, err
+	}
+	// In files that use editions, the presence of a field is a feature rather
+	// than a label, and the fields that have explicit presence are converted
+	// like proto3 optional fields. Fields within a oneof have explicit
+	// presence regardless.
+	features := c.resolveFeatures(fieldDescriptor.GetOptions().GetFeatures())
+	if features != nil && fieldDescriptor.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL && fieldDescriptor.OneofIndex == nil && features.GetFieldPresence() == descriptorpb.FeatureSet_EXPLICIT {
+		typeSpecifier = presenceOf(typeSpecifier)
+	}
+
+	// TODO 2023.11.09: how are protobuf maps represented in the descriptor?
+
+	var unionIndex *uint64
+	if fieldDescriptor.OneofIndex != nil {
+		unionIndex = new(uint64)
+		*unionIndex = (uint64)(*fieldDescriptor.OneofIndex)
+	}
+
+	var annotationApplications []*proto.AnnotationApplication
+	if fieldDescriptor.JsonName != nil {
+		annotationApplications = appendProtobufAnnotationString(annotationApplications, "JsonName", *fieldDescriptor.JsonName)
+	}
+	annotationApplications = appendProtobufAnnotationBoolean(annotationApplications, "Proto3Optional", fieldDescriptor.Proto3Optional != nil && *fieldDescriptor.Proto3Optional)
+	// Required fields and groups are proto2 features that have no microglot
+	// equivalent, so they are only recorded when they are used.
+	if fieldDescriptor.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REQUIRED || features.GetFieldPresence() == descriptorpb.FeatureSet_LEGACY_REQUIRED {
+		annotationApplications = appendProtobufAnnotationBoolean(annotationApplications, "Required", true)
+	}
+	// Whether repeated fields are packed is only resolved for files that use
+	// editions, in which it is a feature rather than an option.
+	if features != nil && fieldDescriptor.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED && isPackable(fieldDescriptor) {
+		annotationApplications = appendProtobufAnnotationBoolean(annotationApplications, "Packed", features.GetRepeatedFieldEncoding() == descriptorpb.FeatureSet_PACKED)
+	}
+	if fieldDescriptor.GetType() == descriptorpb.FieldDescriptorProto_TYPE_GROUP {
+		annotationApplications = appendProtobufAnnotationBoolean(annotationApplications, "Group", true)
+	}
+	// Integers that are encoded with zigzag or fixed encodings have the same
+	// microglot types as the others, so the encoding is recorded instead.
+	if fieldType, ok := idl.FieldTypeName(fieldDescriptor.GetType()); ok {
+		annotationApplications = appendProtobufAnnotationString(annotationApplications, "FieldType", fieldType)
+	}
+	options, err := c.fromOptions(fieldDescriptor.GetOptions(), fieldDescriptor.GetOptions().GetUninterpretedOption())
+	if err != nil {
+		return nil, err
+	}
+	annotationApplications = append(annotationApplications, options...)
+
+	return &proto.Field{
+		Reference: &proto.AttributeReference{
+			ModuleUID:    idl.Incomplete,
+			TypeUID:      idl.Incomplete,
+			AttributeUID: (uint64)(*fieldDescriptor.Number),
+		},
+		Name:                   *fieldDescriptor.Name,
+		Type:                   typeSpecifier,
+		DefaultValue:           defaultValue,
+		UnionIndex:             unionIndex,
+		AnnotationApplications: annotationApplications,
+
+		CommentBlock: c.fromSourceCodeInfo(),
+		Location:     c.fromSourceLocation(),
+	}, nil
+}
+
+// fromExtensionDescriptors converts the extensions at the current path, which
+// are declared either at the top level of the file or within a message, to
+// fields of the structs that they extend. Extensions of the protobuf options
+// messages at the top level are skipped because fromExtensions converts them
+// to annotations. Those within a message aren't supported, so they are kept as
+// they are for the checker to report.
+func (c *fileDescriptorConverter) fromExtensionDescriptors(extensionDescriptors []*descriptorpb.FieldDescriptorProto, nested bool) ([]*proto.Extension, error) {
+	var extensions []*proto.Extension
+	for _, extensionDescriptor := range extensionDescriptors {
+		if _, ok := c.optionsScope(extensionDescriptor.GetExtendee()); nested || !ok {
+			field, err := c.fromFieldDescriptorProto(extensionDescriptor)
+			if err != nil {
+				return nil, err
+			}
+			extensions = append(extensions, &proto.Extension{
+				Extendee: &proto.TypeSpecifier{
+					Reference: &proto.TypeSpecifier_Forward{
+						Forward: &proto.ForwardReference{
+							Reference: &proto.ForwardReference_Protobuf{
+								Protobuf: extensionDescriptor.GetExtendee(),
+							},
+						},
+					},
+					Location: c.fromSourceLocation( /* Extendee */ 2),
+				},
+				// The ModuleUID and TypeUID of the field are those of the
+				// extendee, which are completed when it is linked.
+				Field: field,
+			})
+		}
+		c.p.IncrementIndex()
+	}
+	return extensions, nil
+}
+
+// fieldTypeName returns the name of the type of a field, or of an extension,
+// which is either a built-in microglot type or a protobuf type name.
+func fieldTypeName(fieldDescriptor *descriptorpb.FieldDescriptorProto) string {
+	typeName := ""
+	if fieldDescriptor.Type == nil || *fieldDescriptor.Type == descriptorpb.FieldDescriptorProto_TYPE_GROUP || *fieldDescriptor.Type == descriptorpb.FieldDescriptorProto_TYPE_MESSAGE || *fieldDescriptor.Type == descriptorpb.FieldDescriptorProto_TYPE_ENUM {
+		typeName = *fieldDescriptor.TypeName
+	} else {
+		switch *fieldDescriptor.Type {
+		case descriptorpb.FieldDescriptorProto_TYPE_DOUBLE:
+			typeName = "Float64"
+		case descriptorpb.FieldDescriptorProto_TYPE_FLOAT:
+			typeName = "Float32"
+		case descriptorpb.FieldDescriptorProto_TYPE_INT64:
+			typeName = "Int64"
+		case descriptorpb.FieldDescriptorProto_TYPE_UINT64:
+			typeName = "UInt64"
+		case descriptorpb.FieldDescriptorProto_TYPE_INT32:
+			typeName = "Int32"
+		case descriptorpb.FieldDescriptorProto_TYPE_FIXED64:
+			typeName = "UInt64"
+		case descriptorpb.FieldDescriptorProto_TYPE_FIXED32:
+			typeName = "UInt32"
+		case descriptorpb.FieldDescriptorProto_TYPE_BOOL:
+			typeName = "Bool"
+		case descriptorpb.FieldDescriptorProto_TYPE_STRING:
+			typeName = "Text"
+		case descriptorpb.FieldDescriptorProto_TYPE_BYTES:
+			typeName = "Data"
+		case descriptorpb.FieldDescriptorProto_TYPE_UINT32:
+			typeName = "UInt32"
+		case descriptorpb.FieldDescriptorProto_TYPE_SFIXED32:
+			typeName = "Int32"
+		case descriptorpb.FieldDescriptorProto_TYPE_SFIXED64:
+			typeName = "Int64"
+		case descriptorpb.FieldDescriptorProto_TYPE_SINT32:
+			typeName = "Int32"
+		case descriptorpb.FieldDescriptorProto_TYPE_SINT64:
+			typeName = "Int64"
+		}
+	}
+	return typeName
+}
 
+// fromFieldType converts the type of a field, or of an extension, whose type
+// name is given, to a forward reference that is wrapped according to the
+// field's label.
+func (c *fileDescriptorConverter) fromFieldType(fieldDescriptor *descriptorpb.FieldDescriptorProto, typeName string) (*proto.TypeSpecifier, error) {
+	typeLocation := c.fromSourceLocation( /* TypeName */ 6)
+	if typeLocation == nil {
+		typeLocation = c.fromSourceLocation( /* Type */ 5)
+	}
 	forwardTypeSpecifier := proto.TypeSpecifier{
 		Reference: &proto.TypeSpecifier_Forward{
 			Forward: &proto.ForwardReference{
@@ -538,6 +737,7 @@ func (c *fileDescriptorConverter) fromFieldDescriptorProto(fieldDescriptor *desc
 				},
 			},
 		},
+		Location: typeLocation,
 	}
 
 	typeSpecifier := forwardTypeSpecifier
@@ -545,24 +745,10 @@ func (c *fileDescriptorConverter) fromFieldDescriptorProto(fieldDescriptor *desc
 		switch *fieldDescriptor.Label {
 		case descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL:
 			if fieldDescriptor.Proto3Optional != nil && *fieldDescriptor.Proto3Optional {
-				typeSpecifier = proto.TypeSpecifier{
-					Reference: &proto.TypeSpecifier_Forward{
-						Forward: &proto.ForwardReference{
-							Reference: &proto.ForwardReference_Microglot{
-								Microglot: &proto.MicroglotForwardReference{
-									Qualifier: "",
-									Name: &proto.TypeName{
-										Name: "Presence",
-										Parameters: []*proto.TypeSpecifier{
-											&forwardTypeSpecifier,
-										},
-									},
-								},
-							},
-						},
-					},
-				}
+				typeSpecifier = *presenceOf(&forwardTypeSpecifier)
 			}
+		case descriptorpb.FieldDescriptorProto_LABEL_REQUIRED:
+			// The field is annotated with $(Protobuf.Required()) instead.
 		case descriptorpb.FieldDescriptorProto_LABEL_REPEATED:
 			typeSpecifier = proto.TypeSpecifier{
 				Reference: &proto.TypeSpecifier_Forward{
@@ -580,40 +766,35 @@ func (c *fileDescriptorConverter) fromFieldDescriptorProto(fieldDescriptor *desc
 						},
 					},
 				},
+				Location: typeLocation,
 			}
 		default:
 			return nil, fmt.Errorf("unimplemented protobuf label %s", *fieldDescriptor.Label)
 		}
 	}
+	return &typeSpecifier, nil
+}
 
-	// TODO 2023.11.09: how are protobuf maps represented in the descriptor?
-
-	var unionIndex *uint64
-	if fieldDescriptor.OneofIndex != nil {
-		unionIndex = new(uint64)
-		*unionIndex = (uint64)(*fieldDescriptor.OneofIndex)
-	}
-
-	var annotationApplications []*proto.AnnotationApplication
-	if fieldDescriptor.JsonName != nil {
-		annotationApplications = appendProtobufAnnotationString(annotationApplications, "JsonName", *fieldDescriptor.JsonName)
-	}
-	annotationApplications = appendProtobufAnnotationBoolean(annotationApplications, "Proto3Optional", fieldDescriptor.Proto3Optional != nil && *fieldDescriptor.Proto3Optional)
-
-	return &proto.Field{
-		Reference: &proto.AttributeReference{
-			ModuleUID:    idl.Incomplete,
-			TypeUID:      idl.Incomplete,
-			AttributeUID: (uint64)(*fieldDescriptor.Number),
+// presenceOf wraps a type in Presence.
+func presenceOf(typeSpecifier *proto.TypeSpecifier) *proto.TypeSpecifier {
+	return &proto.TypeSpecifier{
+		Reference: &proto.TypeSpecifier_Forward{
+			Forward: &proto.ForwardReference{
+				Reference: &proto.ForwardReference_Microglot{
+					Microglot: &proto.MicroglotForwardReference{
+						Qualifier: "",
+						Name: &proto.TypeName{
+							Name: "Presence",
+							Parameters: []*proto.TypeSpecifier{
+								typeSpecifier,
+							},
+						},
+					},
+				},
+			},
 		},
-		Name:                   *fieldDescriptor.Name,
-		Type:                   &typeSpecifier,
-		DefaultValue:           defaultValue,
-		UnionIndex:             unionIndex,
-		AnnotationApplications: annotationApplications,
-
-		CommentBlock: c.fromSourceCodeInfo(),
-	}, nil
+		Location: typeSpecifier.Location,
+	}
 }
 
 func getUnregisteredOption(name string, options []*descriptorpb.UninterpretedOption) (string, bool) {
@@ -644,35 +825,108 @@ func getUnregisteredOption(name string, options []*descriptorpb.UninterpretedOpt
 }
 
 func (c *fileDescriptorConverter) fromEnumDescriptorProto(enumDescriptor *descriptorpb.EnumDescriptorProto) (*proto.Enum, error) {
+	c.p.PushFieldNumber( /* Value */ 2)
+	c.p.PushIndex()
 	enumerants, err := mapFrom(c.p, enumDescriptor.Value, c.fromEnumValueDescriptorProto)
 	if err != nil {
 		return nil, err
 	}
+	c.p.PopIndex()
+	c.p.PopFieldNumber()
 
 	result := &proto.Enum{
 		Reference: &proto.TypeReference{
 			ModuleUID: idl.Incomplete,
 			TypeUID:   idl.Incomplete,
 		},
-		Name:       *enumDescriptor.Name,
-		Enumerants: enumerants,
-		// Reserved:
-		// ReservedNames:
+		Name:          *enumDescriptor.Name,
+		Enumerants:    enumerants,
+		Reserved:      fromEnumReservedRanges(enumDescriptor.ReservedRange),
+		ReservedNames: enumDescriptor.ReservedName,
 		// CommentBlock:
 		// AnnotationApplications:
+		Location: c.fromSourceLocation(),
 	}
-	// TODO 2023.10.10: convert official Options
 	result.AnnotationApplications = appendProtobufAnnotationBoolean(result.AnnotationApplications, "EnumFromProto", true)
+	// Enums of files that use editions are closed when the enum_type feature
+	// says so, as all proto2 enums are.
+	if features := c.resolveFeatures(enumDescriptor.GetOptions().GetFeatures()); features.GetEnumType() == descriptorpb.FeatureSet_CLOSED {
+		result.AnnotationApplications = appendProtobufAnnotationBoolean(result.AnnotationApplications, "ClosedEnum", true)
+	}
+	options, err := c.fromOptions(enumDescriptor.GetOptions(), enumDescriptor.GetOptions().GetUninterpretedOption())
+	if err != nil {
+		return nil, err
+	}
+	result.AnnotationApplications = append(result.AnnotationApplications, options...)
 	return result, nil
 }
 
+// fromMessageReservedRanges converts the reserved field numbers of a message,
+// which exclude the end of each range, to inclusive ranges of UIDs.
+func fromMessageReservedRanges(ranges []*descriptorpb.DescriptorProto_ReservedRange) []*proto.ReservedRange {
+	var result []*proto.ReservedRange
+	for _, r := range ranges {
+		if r.GetEnd() <= r.GetStart() {
+			continue
+		}
+		result = append(result, &proto.ReservedRange{
+			Start: uint64(r.GetStart()),
+			End:   uint64(r.GetEnd() - 1),
+		})
+	}
+	return result
+}
+
+// fromExtensionRanges converts the extension ranges of a message, which
+// exclude the end of each range, to inclusive ranges of UIDs.
+func (c *fileDescriptorConverter) fromExtensionRanges(ranges []*descriptorpb.DescriptorProto_ExtensionRange) []*proto.ExtensionRange {
+	var result []*proto.ExtensionRange
+	for index, r := range ranges {
+		if r.GetEnd() <= r.GetStart() {
+			continue
+		}
+		result = append(result, &proto.ExtensionRange{
+			Start:    uint64(r.GetStart()),
+			End:      uint64(r.GetEnd() - 1),
+			Location: c.fromSourceLocation( /* ExtensionRange */ 5, int32(index)),
+		})
+	}
+	return result
+}
+
+// fromEnumReservedRanges converts the reserved values of an enum to ranges of
+// UIDs. Negative values are converted the same way as enumerants, which means
+// that a range that spans zero has to be split in two.
+func fromEnumReservedRanges(ranges []*descriptorpb.EnumDescriptorProto_EnumReservedRange) []*proto.ReservedRange {
+	var result []*proto.ReservedRange
+	for _, r := range ranges {
+		start, end := r.GetStart(), r.GetEnd()
+		if start < 0 && end >= 0 {
+			result = append(result, &proto.ReservedRange{
+				Start: uint64(start),
+				// -1
+				End: math.MaxUint64,
+			})
+			start = 0
+		}
+		result = append(result, &proto.ReservedRange{
+			Start: uint64(start),
+			End:   uint64(end),
+		})
+	}
+	return result
+}
+
 func (c *fileDescriptorConverter) fromEnumValueDescriptorProto(enumValueDescriptor *descriptorpb.EnumValueDescriptorProto) (*proto.Enumerant, error) {
-	// TODO 2023.10.10: convert Options
 	name := *enumValueDescriptor.Name
 	trueName, ok := getUnregisteredOption("MicroglotName", enumValueDescriptor.GetOptions().GetUninterpretedOption())
 	if ok {
 		name = trueName
 	}
+	annotationApplications, err := c.fromOptions(enumValueDescriptor.GetOptions(), enumValueDescriptor.GetOptions().GetUninterpretedOption())
+	if err != nil {
+		return nil, err
+	}
 	return &proto.Enumerant{
 		Reference: &proto.AttributeReference{
 			ModuleUID:    idl.Incomplete,
@@ -681,17 +935,25 @@ func (c *fileDescriptorConverter) fromEnumValueDescriptorProto(enumValueDescript
 		},
 		Name: name,
 		// CommentBlock:
-		// AnnotationApplications:
+		AnnotationApplications: annotationApplications,
+		Location:               c.fromSourceLocation(),
 	}, nil
 }
 
 func (c *fileDescriptorConverter) fromServiceDescriptorProto(serviceDescriptor *descriptorpb.ServiceDescriptorProto) (*proto.API, error) {
+	c.p.PushFieldNumber( /* Method */ 2)
+	c.p.PushIndex()
 	methods, err := mapFrom(c.p, serviceDescriptor.Method, c.fromMethodDescriptorProto)
 	if err != nil {
 		return nil, err
 	}
+	c.p.PopIndex()
+	c.p.PopFieldNumber()
 
-	// TODO 2023.10.10: convert Options
+	annotationApplications, err := c.fromOptions(serviceDescriptor.GetOptions(), serviceDescriptor.GetOptions().GetUninterpretedOption())
+	if err != nil {
+		return nil, err
+	}
 
 	return &proto.API{
 		Reference: &proto.TypeReference{
@@ -706,20 +968,17 @@ func (c *fileDescriptorConverter) fromServiceDescriptorProto(serviceDescriptor *
 		// Reserved:
 		// ReservedNames:
 		// CommentBlock:
-		// AnnotationApplications:
+		AnnotationApplications: annotationApplications,
+		Location:               c.fromSourceLocation(),
 	}, nil
 }
 
 func (c *fileDescriptorConverter) fromMethodDescriptorProto(methodDescriptor *descriptorpb.MethodDescriptorProto) (*proto.APIMethod, error) {
-	if methodDescriptor.ClientStreaming != nil && *methodDescriptor.ClientStreaming {
-		return nil, errors.New("client streaming protobufs have no microglot equivalent")
-	}
-	if methodDescriptor.ServerStreaming != nil && *methodDescriptor.ServerStreaming {
-		return nil, errors.New("server streaming protobufs have no microglot equivalent")
+	annotationApplications, err := c.fromOptions(methodDescriptor.GetOptions(), methodDescriptor.GetOptions().GetUninterpretedOption())
+	if err != nil {
+		return nil, err
 	}
 
-	// TODO 2023.10.10: convert Options
-
 	return &proto.APIMethod{
 		Reference: &proto.AttributeReference{
 			ModuleUID:    idl.Incomplete,
@@ -735,6 +994,7 @@ func (c *fileDescriptorConverter) fromMethodDescriptorProto(methodDescriptor *de
 					},
 				},
 			},
+			Location: c.fromSourceLocation( /* InputType */ 2),
 		},
 		Output: &proto.TypeSpecifier{
 			Reference: &proto.TypeSpecifier_Forward{
@@ -744,9 +1004,13 @@ func (c *fileDescriptorConverter) fromMethodDescriptorProto(methodDescriptor *de
 					},
 				},
 			},
+			Location: c.fromSourceLocation( /* OutputType */ 3),
 		},
 		// CommentBlock
-		// AnnotationApplication
+		AnnotationApplications: annotationApplications,
+		Location:               c.fromSourceLocation(),
+		InputStream:            methodDescriptor.GetClientStreaming(),
+		OutputStream:           methodDescriptor.GetServerStreaming(),
 	}, nil
 }
 
@@ -784,3 +1048,23 @@ func (c *fileDescriptorConverter) fromSourceCodeInfo() *proto.CommentBlock {
 	}
 	return nil
 }
+
+// fromSourceLocation returns the start of the span recorded in the optional
+// SourceCodeInfo for the element at the current path, extended by any given
+// path components. The SourceCodeInfo spans are 0-based so they are converted
+// to match the 1-based positions that protocompile reports for parse errors.
+func (c *fileDescriptorConverter) fromSourceLocation(components ...int32) *proto.SourceLocation {
+	if c.fileDescriptor.SourceCodeInfo == nil {
+		return nil
+	}
+	currentPath := append(c.p.CopyPath(), components...)
+	for _, location := range c.fileDescriptor.SourceCodeInfo.Location {
+		if len(location.Span) >= 2 && slices.Equal(location.Path, currentPath) {
+			return &proto.SourceLocation{
+				Line:   location.Span[0] + 1,
+				Column: location.Span[1] + 1,
+			}
+		}
+	}
+	return nil
+}
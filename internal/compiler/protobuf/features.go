@@ -0,0 +1,44 @@
+// © 2023 Microglot LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package protobuf
+
+import (
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"gopkg.microglot.org/mglotc/internal/idl"
+)
+
+// resolveFeatures returns the features of a declaration of a file that uses
+// editions, which are the defaults of the edition overridden by the features
+// of the file and then by those of the declaration. It returns nil for files
+// that use proto2 or proto3.
+//
+// The features that are converted to microglot, which are field presence,
+// enum openness and packed encoding, can only be set on files and on the
+// fields or enums that they apply to, so the messages that enclose a
+// declaration don't need to be taken into account.
+func (c *fileDescriptorConverter) resolveFeatures(features *descriptorpb.FeatureSet) *descriptorpb.FeatureSet {
+	if c.fileDescriptor.GetSyntax() != "editions" {
+		return nil
+	}
+	return idl.ResolveFeatures(c.fileDescriptor.GetEdition(), c.fileDescriptor.GetOptions().GetFeatures(), features)
+}
+
+// isPackable reports whether the values of a repeated field can be packed,
+// which is true of fields of scalar types other than strings and bytes, and
+// of enums. Fields that are parsed from source have no type when they name a
+// message or an enum, so those are taken to be packable until they are linked,
+// after which the compiler removes $(Protobuf.Packed()) from the fields that
+// name a message.
+func isPackable(fieldDescriptor *descriptorpb.FieldDescriptorProto) bool {
+	if fieldDescriptor.Type == nil {
+		return true
+	}
+	switch fieldDescriptor.GetType() {
+	case descriptorpb.FieldDescriptorProto_TYPE_STRING, descriptorpb.FieldDescriptorProto_TYPE_BYTES, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, descriptorpb.FieldDescriptorProto_TYPE_GROUP:
+		return false
+	}
+	return true
+}
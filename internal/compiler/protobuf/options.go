@@ -0,0 +1,335 @@
+// © 2023 Microglot LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package protobuf
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/bufbuild/protocompile/ast"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"gopkg.microglot.org/mglotc/internal/idl"
+	"gopkg.microglot.org/mglotc/internal/proto"
+)
+
+// optionsScopes maps each protobuf options message to the scope of the
+// annotations that represent its extensions.
+var optionsScopes = map[string]proto.AnnotationScope{
+	"google.protobuf.FileOptions":      proto.AnnotationScope_AnnotationScopeModule,
+	"google.protobuf.MessageOptions":   proto.AnnotationScope_AnnotationScopeStruct,
+	"google.protobuf.FieldOptions":     proto.AnnotationScope_AnnotationScopeField,
+	"google.protobuf.OneofOptions":     proto.AnnotationScope_AnnotationScopeUnion,
+	"google.protobuf.EnumOptions":      proto.AnnotationScope_AnnotationScopeEnum,
+	"google.protobuf.EnumValueOptions": proto.AnnotationScope_AnnotationScopeEnumerant,
+	"google.protobuf.ServiceOptions":   proto.AnnotationScope_AnnotationScopeAPI,
+	"google.protobuf.MethodOptions":    proto.AnnotationScope_AnnotationScopeAPIMethod,
+}
+
+func (c *fileDescriptorConverter) optionsScope(extendee string) (proto.AnnotationScope, bool) {
+	name := strings.TrimPrefix(extendee, ".")
+	scope, ok := optionsScopes[name]
+	if !ok && !strings.HasPrefix(extendee, ".") && c.fileDescriptor.GetPackage() == "google.protobuf" {
+		scope, ok = optionsScopes["google.protobuf."+name]
+	}
+	return scope, ok
+}
+
+// extensionUID returns the UID of the annotation that represents an extension
+// of an options message. The low bits are the number of the extension, see
+// idl.ExtensionNumber, and the rest are derived from its full name so that
+// extensions of different options messages with the same number don't collide.
+func (c *fileDescriptorConverter) extensionUID(extension *descriptorpb.FieldDescriptorProto) uint64 {
+	hasher := sha256.New()
+	hasher.Write([]byte(c.fileDescriptor.GetPackage()))
+	hasher.Write([]byte{'.'})
+	hasher.Write([]byte(extension.GetName()))
+	uid := binary.LittleEndian.Uint64(hasher.Sum(nil))
+	return uid&^idl.MaxFieldUID | uint64(extension.GetNumber())&idl.MaxFieldUID
+}
+
+// fromExtensions converts the extensions of the protobuf options messages that
+// are declared at the top level of the file to annotations. Extensions of any
+// other message are converted by fromExtensionDescriptors.
+func (c *fileDescriptorConverter) fromExtensions() ([]*proto.Annotation, error) {
+	var annotations []*proto.Annotation
+	c.p.PushFieldNumber( /* Extension */ 7)
+	c.p.PushIndex()
+	for _, extension := range c.fileDescriptor.Extension {
+		scope, ok := c.optionsScope(extension.GetExtendee())
+		if ok {
+			type_, err := c.fromFieldType(extension, fieldTypeName(extension))
+			if err != nil {
+				return nil, err
+			}
+			annotations = append(annotations, &proto.Annotation{
+				Reference: &proto.TypeReference{
+					ModuleUID: idl.Incomplete,
+					TypeUID:   c.extensionUID(extension),
+				},
+				Name:                   extension.GetName(),
+				Scopes:                 []proto.AnnotationScope{scope},
+				Type:                   type_,
+				DescriptorCommentBlock: c.fromSourceCodeInfo(),
+				Location:               c.fromSourceLocation(),
+			})
+		}
+		c.p.IncrementIndex()
+	}
+	c.p.PopIndex()
+	c.p.PopFieldNumber()
+	return annotations, nil
+}
+
+// fromOptions converts the options of a declaration to annotation
+// applications. The standard options, which are the fields of the options
+// message, are converted to the Protobuf annotations that represent them, and
+// are followed by the custom options.
+func (c *fileDescriptorConverter) fromOptions(options protoreflect.ProtoMessage, uninterpretedOptions []*descriptorpb.UninterpretedOption) ([]*proto.AnnotationApplication, error) {
+	customOptions, err := c.fromCustomOptions(uninterpretedOptions)
+	if err != nil {
+		return nil, err
+	}
+	return append(idl.FromOptions(options), customOptions...), nil
+}
+
+// fromCustomOptions converts the custom options of a declaration, which are
+// those named by an extension, to applications of the annotations that the
+// extensions are converted to. The values are converted as they are written
+// because their types are only known once the annotations are linked, after
+// which the compiler interprets them. Options that set the fields of the same
+// extension, such as (foo).a and (foo).b, are merged into one application.
+//
+// Only options that were parsed from source are converted. Descriptor sets
+// hold custom options as unknown fields of the options messages instead.
+func (c *fileDescriptorConverter) fromCustomOptions(options []*descriptorpb.UninterpretedOption) ([]*proto.AnnotationApplication, error) {
+	if c.result == nil {
+		return nil, nil
+	}
+	var annotationApplications []*proto.AnnotationApplication
+	for _, option := range options {
+		if len(option.Name) < 1 || !option.Name[0].GetIsExtension() {
+			continue
+		}
+		node := c.result.OptionNode(option)
+		value, err := c.fromOptionValue(node.GetValue())
+		if err != nil {
+			return nil, err
+		}
+		for index := len(option.Name) - 1; index > 0; index = index - 1 {
+			if option.Name[index].GetIsExtension() {
+				return nil, fmt.Errorf("option %s: extensions within custom options aren't supported", optionName(option))
+			}
+			value = &proto.Value{
+				Kind: &proto.Value_Struct{
+					Struct: &proto.ValueStruct{
+						Fields: []*proto.ValueStructField{
+							&proto.ValueStructField{
+								Name:  option.Name[index].GetNamePart(),
+								Value: value,
+							},
+						},
+					},
+				},
+				Location: value.Location,
+			}
+		}
+
+		name := option.Name[0].GetNamePart()
+		merged := false
+		for _, annotationApplication := range annotationApplications {
+			if annotationApplication.Annotation.GetForward().GetProtobuf() == name {
+				annotationApplication.Value = mergeOptionValues(annotationApplication.Value, value, len(option.Name) > 1)
+				merged = true
+				break
+			}
+		}
+		if merged {
+			continue
+		}
+		location := c.nodeLocation(node.GetName())
+		annotationApplications = append(annotationApplications, &proto.AnnotationApplication{
+			Annotation: &proto.TypeSpecifier{
+				Reference: &proto.TypeSpecifier_Forward{
+					Forward: &proto.ForwardReference{
+						Reference: &proto.ForwardReference_Protobuf{
+							Protobuf: name,
+						},
+					},
+				},
+				Location: location,
+			},
+			Value:    value,
+			Location: location,
+		})
+	}
+	return annotationApplications, nil
+}
+
+// mergeOptionValues combines the values of two options that set the same
+// extension. Setting a field of a message extension adds to the fields that
+// are already set, and a field that is set more than once is resolved when the
+// option is interpreted. Setting the whole extension again makes a list, which
+// is only valid for a repeated extension.
+func mergeOptionValues(existing *proto.Value, value *proto.Value, isField bool) *proto.Value {
+	if isField {
+		existingStruct, ok := existing.Kind.(*proto.Value_Struct)
+		if ok {
+			existingStruct.Struct.Fields = append(existingStruct.Struct.Fields, value.GetStruct().Fields...)
+			return existing
+		}
+	}
+	if list, ok := existing.Kind.(*proto.Value_List); ok {
+		list.List.Elements = append(list.List.Elements, value)
+		return existing
+	}
+	return &proto.Value{
+		Kind: &proto.Value_List{
+			List: &proto.ValueList{
+				Elements: []*proto.Value{existing, value},
+			},
+		},
+		Location: existing.Location,
+	}
+}
+
+// fromOptionValue converts the value of a custom option as it is written in
+// the protobuf text format. Integers are kept as wide as possible, and
+// identifiers other than true and false are kept as identifiers because they
+// can only be resolved against the type of the option.
+func (c *fileDescriptorConverter) fromOptionValue(node ast.ValueNode) (*proto.Value, error) {
+	location := c.nodeLocation(node)
+	source := c.result.AST().NodeInfo(node).RawText()
+	switch n := node.(type) {
+	case ast.StringValueNode:
+		return &proto.Value{
+			Kind: &proto.Value_Text{
+				Text: &proto.ValueText{
+					Value:  n.AsString(),
+					Source: source,
+				},
+			},
+			Location: location,
+		}, nil
+	case *ast.NegativeIntLiteralNode:
+		v, _ := n.AsInt64()
+		return &proto.Value{
+			Kind: &proto.Value_Int64{
+				Int64: &proto.ValueInt64{
+					Value:  v,
+					Source: source,
+				},
+			},
+			Location: location,
+		}, nil
+	case ast.IntValueNode:
+		v, _ := n.AsUint64()
+		return &proto.Value{
+			Kind: &proto.Value_UInt64{
+				UInt64: &proto.ValueUInt64{
+					Value:  v,
+					Source: source,
+				},
+			},
+			Location: location,
+		}, nil
+	case ast.FloatValueNode:
+		return &proto.Value{
+			Kind: &proto.Value_Float64{
+				Float64: &proto.ValueFloat64{
+					Value:  n.AsFloat(),
+					Source: source,
+				},
+			},
+			Location: location,
+		}, nil
+	case ast.IdentValueNode:
+		identifier := string(n.AsIdentifier())
+		if identifier == "true" || identifier == "false" {
+			return &proto.Value{
+				Kind: &proto.Value_Bool{
+					Bool: &proto.ValueBool{
+						Value:  identifier == "true",
+						Source: source,
+					},
+				},
+				Location: location,
+			}, nil
+		}
+		return &proto.Value{
+			Kind: &proto.Value_Identifier{
+				Identifier: &proto.ValueIdentifier{
+					Names: []string{identifier},
+				},
+			},
+			Location: location,
+		}, nil
+	case *ast.ArrayLiteralNode:
+		elements := make([]*proto.Value, 0, len(n.Elements))
+		for _, element := range n.Elements {
+			value, err := c.fromOptionValue(element)
+			if err != nil {
+				return nil, err
+			}
+			elements = append(elements, value)
+		}
+		return &proto.Value{
+			Kind: &proto.Value_List{
+				List: &proto.ValueList{
+					Elements: elements,
+				},
+			},
+			Location: location,
+		}, nil
+	case *ast.MessageLiteralNode:
+		fields := make([]*proto.ValueStructField, 0, len(n.Elements))
+		for _, element := range n.Elements {
+			if element.Name.IsExtension() || element.Name.IsAnyTypeReference() {
+				return nil, fmt.Errorf("option value %s: extensions within custom options aren't supported", element.Name.Value())
+			}
+			value, err := c.fromOptionValue(element.Val)
+			if err != nil {
+				return nil, err
+			}
+			fields = append(fields, &proto.ValueStructField{
+				Name:  string(element.Name.Name.AsIdentifier()),
+				Value: value,
+			})
+		}
+		return &proto.Value{
+			Kind: &proto.Value_Struct{
+				Struct: &proto.ValueStruct{
+					Fields: fields,
+				},
+			},
+			Location: location,
+		}, nil
+	}
+	return nil, fmt.Errorf("unsupported option value %s", source)
+}
+
+func (c *fileDescriptorConverter) nodeLocation(node ast.Node) *proto.SourceLocation {
+	start := c.result.AST().NodeInfo(node).Start()
+	return &proto.SourceLocation{
+		Line:   int32(start.Line),
+		Column: int32(start.Col),
+		Offset: int64(start.Offset),
+	}
+}
+
+func optionName(option *descriptorpb.UninterpretedOption) string {
+	parts := make([]string, 0, len(option.Name))
+	for _, part := range option.Name {
+		if part.GetIsExtension() {
+			parts = append(parts, "("+part.GetNamePart()+")")
+		} else {
+			parts = append(parts, part.GetNamePart())
+		}
+	}
+	return strings.Join(parts, ".")
+}
@@ -0,0 +1,251 @@
+// © 2023 Microglot LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"gopkg.microglot.org/mglotc/internal/exc"
+	"gopkg.microglot.org/mglotc/internal/fs"
+	"gopkg.microglot.org/mglotc/internal/idl"
+	"gopkg.microglot.org/mglotc/internal/proto"
+	"gopkg.microglot.org/mglotc/internal/target"
+)
+
+const protobufURI = "/protobuf.mglot"
+
+// scheduler runs the compile and link jobs for a single call to Compile.
+//
+// Each file is compiled by its own job, and every import it declares is
+// scheduled as another job, once, as soon as the importing module is
+// collected. A microglot module is linked as soon as every module it imports
+// has been collected, so linking overlaps with the compilation of unrelated
+// files. Protobuf type names are resolved against every module in the symbol
+// table, though, so any other module is only linked once every file has been
+// compiled. The number of jobs that run at once is bounded by the compiler's
+// semaphore.
+//
+// All of the bookkeeping is done by the goroutine that calls wait(), so none of
+// it needs to be synchronized. Jobs only ever communicate through results.
+type scheduler struct {
+	compiler   *compiler
+	ctx        context.Context
+	cancel     context.CancelFunc
+	dumpTokens bool
+	dumpTree   bool
+	symbols    *globalSymbolTable
+	results    chan jobResult
+	jobs       sync.WaitGroup
+	pending    int
+	// compiling is the number of compile jobs that haven't finished yet.
+	compiling int
+	// scheduled contains every URI that has been, or is being, compiled.
+	scheduled map[string]bool
+	// collected contains every module that has been added to the symbols.
+	collected map[string]*proto.Module
+	linking   map[string]bool
+	linked    map[string]*proto.Module
+	// failed is the first error returned by any job.
+	failed error
+}
+
+type jobResult struct {
+	link    bool
+	modules []*proto.Module
+	err     error
+}
+
+func newScheduler(ctx context.Context, c *compiler, dumpTokens bool, dumpTree bool) *scheduler {
+	ctx, cancel := context.WithCancel(ctx)
+	return &scheduler{
+		compiler:   c,
+		ctx:        ctx,
+		cancel:     cancel,
+		dumpTokens: dumpTokens,
+		dumpTree:   dumpTree,
+		symbols:    &globalSymbolTable{},
+		results:    make(chan jobResult),
+		scheduled:  make(map[string]bool),
+		collected:  make(map[string]*proto.Module),
+		linking:    make(map[string]bool),
+		linked:     make(map[string]*proto.Module),
+	}
+}
+
+// stop cancels any outstanding jobs and waits for them to exit. It must be
+// called once the scheduler is no longer needed.
+func (s *scheduler) stop() {
+	s.cancel()
+	s.jobs.Wait()
+}
+
+// start runs job in a new goroutine once the semaphore allows it.
+func (s *scheduler) start(job func() jobResult) {
+	s.pending = s.pending + 1
+	s.jobs.Add(1)
+	go func() {
+		defer s.jobs.Done()
+		var result jobResult
+		if err := s.compiler.Semaphore.LockContext(s.ctx); err != nil {
+			result = jobResult{err: err}
+		} else {
+			result = job()
+			s.compiler.Semaphore.Unlock()
+		}
+		select {
+		case s.results <- result:
+		case <-s.ctx.Done():
+		}
+	}()
+}
+
+// compile schedules the given files for compilation.
+func (s *scheduler) compile(files ...idl.File) {
+	for _, file := range files {
+		file := file
+		uri := file.Path(s.ctx)
+		if s.scheduled[uri] {
+			continue
+		}
+		s.scheduled[uri] = true
+		s.compiling = s.compiling + 1
+		s.start(func() jobResult {
+			modules, err := s.compiler.compileFile(s.ctx, file, s.dumpTokens, s.dumpTree)
+			return jobResult{modules: modules, err: err}
+		})
+	}
+}
+
+// compileImport schedules the file, or files, that an import refers to.
+func (s *scheduler) compileImport(moduleURI string, import_ *proto.Import, uri string) {
+	s.scheduled[uri] = true
+	location := sourceLocation(moduleURI, import_.Location)
+	s.compiling = s.compiling + 1
+	s.start(func() jobResult {
+		in, err := s.compiler.FS.Open(s.ctx, uri)
+		if err != nil {
+			var e exc.Exception
+			if !errors.As(err, &e) {
+				e = exc.WrapUnknown(location, err)
+			}
+			_ = s.compiler.Reporter.Report(e)
+			return jobResult{err: e}
+		}
+		var modules []*proto.Module
+		for _, inf := range in {
+			if inf.Kind(s.ctx) == idl.FileKindNone {
+				continue
+			}
+			compiled, err := s.compiler.compileFile(s.ctx, inf, s.dumpTokens, s.dumpTree)
+			if err != nil {
+				return jobResult{err: err}
+			}
+			modules = append(modules, compiled...)
+		}
+		return jobResult{modules: modules}
+	})
+}
+
+// wait handles the results of jobs until there are none left, scheduling any
+// follow-up jobs along the way. Every job is waited for, even after one of
+// them has failed, so that the reported errors are the same from run to run
+// rather than depending on which job happened to finish first.
+func (s *scheduler) wait() error {
+	for s.pending > 0 {
+		select {
+		case <-s.ctx.Done():
+			return s.ctx.Err()
+		case result := <-s.results:
+			s.pending = s.pending - 1
+			if !result.link {
+				s.compiling = s.compiling - 1
+			}
+			if result.err != nil {
+				if s.failed == nil {
+					s.failed = result.err
+				}
+				continue
+			}
+			if result.link {
+				for _, module := range result.modules {
+					s.linked[module.URI] = module
+				}
+				continue
+			}
+			s.collect(result.modules)
+		}
+	}
+	return nil
+}
+
+func (s *scheduler) collect(modules []*proto.Module) {
+	added := make([]*proto.Module, 0, len(modules))
+	for _, module := range modules {
+		// Files that contain multiple modules register each module URI so
+		// that imports of those URIs resolve to the contained module rather
+		// than being opened and compiled a second time.
+		if _, ok := s.collected[module.URI]; ok {
+			continue
+		}
+		s.scheduled[module.URI] = true
+		s.collected[module.URI] = module
+		if err := s.symbols.collect(*module, s.compiler.Reporter); err != nil && s.failed == nil {
+			s.failed = err
+		}
+		added = append(added, module)
+	}
+	for _, module := range added {
+		for _, import_ := range module.Imports {
+			uri := target.Normalize(import_.ImportedURI)
+			if !s.scheduled[uri] {
+				s.compileImport(module.URI, import_, uri)
+			}
+		}
+	}
+	s.link()
+}
+
+// link schedules every module that can now be linked.
+func (s *scheduler) link() {
+	for uri, module := range s.collected {
+		if s.linking[uri] || !s.ready(uri) {
+			continue
+		}
+		s.linking[uri] = true
+		mod := *module
+		s.start(func() jobResult {
+			linked, err := link(mod, s.symbols, s.compiler.Reporter)
+			return jobResult{link: true, modules: []*proto.Module{linked}, err: err}
+		})
+	}
+}
+
+// ready reports whether every module that the given module can see, including
+// the built-in protobuf module, has been collected.
+func (s *scheduler) ready(uri string) bool {
+	if fs.KindOf(uri) != idl.FileKindMicroglot && s.compiling > 0 {
+		return false
+	}
+	visited := make(map[string]bool)
+	stack := []string{protobufURI, uri}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if visited[current] {
+			continue
+		}
+		visited[current] = true
+		module, ok := s.collected[current]
+		if !ok {
+			return false
+		}
+		for _, import_ := range module.Imports {
+			stack = append(stack, target.Normalize(import_.ImportedURI))
+		}
+	}
+	return true
+}
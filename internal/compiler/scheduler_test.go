@@ -0,0 +1,124 @@
+// © 2023 Microglot LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"gopkg.microglot.org/mglotc/internal/exc"
+	"gopkg.microglot.org/mglotc/internal/idl"
+	"gopkg.microglot.org/mglotc/internal/proto"
+)
+
+// countingSubCompiler records how often, and how concurrently, each file is
+// compiled. Files listed in block don't finish until the context is done.
+type countingSubCompiler struct {
+	SubCompiler
+	lock    sync.Mutex
+	active  int
+	maximum int
+	counts  map[string]int
+	block   map[string]bool
+	started chan string
+}
+
+func (self *countingSubCompiler) CompileFile(ctx context.Context, r exc.Reporter, file idl.File, dumpTokens bool, dumpTree bool) (*proto.Module, error) {
+	self.lock.Lock()
+	self.active = self.active + 1
+	if self.active > self.maximum {
+		self.maximum = self.active
+	}
+	self.counts[file.Path(ctx)] = self.counts[file.Path(ctx)] + 1
+	self.lock.Unlock()
+	defer func() {
+		self.lock.Lock()
+		self.active = self.active - 1
+		self.lock.Unlock()
+	}()
+
+	if self.started != nil {
+		self.started <- file.Path(ctx)
+	}
+	if self.block[file.Path(ctx)] {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	// give other jobs the chance to overlap with this one
+	time.Sleep(time.Millisecond)
+	return self.SubCompiler.CompileFile(ctx, r, file, dumpTokens, dumpTree)
+}
+
+func newCountingCompiler(t *testing.T, concurrency int, sc *countingSubCompiler, files ...CompilerTestFile) idl.Compiler {
+	c, err := New(OptionWithExcReporter(exc.NewReporter(nil)), OptionWithFS(newTestFS(files...)))
+	require.NoError(t, err)
+	sc.SubCompiler = c.(*compiler).SubCompilers[idl.FileKindMicroglot]
+	sc.counts = make(map[string]int)
+	c.(*compiler).SubCompilers[idl.FileKindMicroglot] = sc
+	c.(*compiler).Semaphore = newSemaphore(concurrency)
+	return c
+}
+
+func TestSchedulerCompilesEachFileOnce(t *testing.T) {
+	t.Parallel()
+
+	files := []CompilerTestFile{
+		{kind: idl.FileKindMicroglot, uri: "/a.mglot", contents: "syntax = \"mglot0\"\nmodule = @11\nimport \"/b.mglot\" as b\nimport \"/c.mglot\" as c\nstruct A { b :b.B\n c :c.C }\n"},
+		{kind: idl.FileKindMicroglot, uri: "/b.mglot", contents: "syntax = \"mglot0\"\nmodule = @12\nimport \"/d.mglot\" as d\nstruct B { d :d.D }\n"},
+		{kind: idl.FileKindMicroglot, uri: "/c.mglot", contents: "syntax = \"mglot0\"\nmodule = @13\nimport \"/d.mglot\" as d\nstruct C { d :d.D }\n"},
+		{kind: idl.FileKindMicroglot, uri: "/d.mglot", contents: "syntax = \"mglot0\"\nmodule = @14\nstruct D {}\n"},
+	}
+	sc := &countingSubCompiler{}
+	c := newCountingCompiler(t, 2, sc, files...)
+	resp, err := c.Compile(context.Background(), &idl.CompileRequest{Files: []string{"/a.mglot", "/d.mglot", "/b.mglot", "/a.mglot"}})
+	require.NoError(t, err)
+	require.Len(t, resp.Image.Modules, 5)
+	require.Equal(t, map[string]int{
+		"/protobuf.mglot": 1,
+		"/a.mglot":        1,
+		"/b.mglot":        1,
+		"/c.mglot":        1,
+		"/d.mglot":        1,
+	}, sc.counts)
+	require.LessOrEqual(t, sc.maximum, 2)
+}
+
+func TestSchedulerCancellation(t *testing.T) {
+	// This test isn't run in parallel because it counts goroutines.
+	baseline := runtime.NumGoroutine()
+
+	files := []CompilerTestFile{
+		{kind: idl.FileKindMicroglot, uri: "/a.mglot", contents: "syntax = \"mglot0\"\nmodule = @11\nimport \"/slow.mglot\" as slow\n"},
+		{kind: idl.FileKindMicroglot, uri: "/b.mglot", contents: "syntax = \"mglot0\"\nmodule = @12\n"},
+		{kind: idl.FileKindMicroglot, uri: "/slow.mglot", contents: "syntax = \"mglot0\"\nmodule = @13\n"},
+	}
+	sc := &countingSubCompiler{
+		block:   map[string]bool{"/slow.mglot": true},
+		started: make(chan string, 8),
+	}
+	c := newCountingCompiler(t, 1, sc, files...)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errs := make(chan error)
+	go func() {
+		_, err := c.Compile(ctx, &idl.CompileRequest{Files: []string{"/a.mglot", "/b.mglot"}})
+		errs <- err
+	}()
+	for started := range sc.started {
+		if started == "/slow.mglot" {
+			break
+		}
+	}
+	cancel()
+	require.ErrorIs(t, <-errs, context.Canceled)
+
+	// Compile doesn't return until all of its jobs have exited.
+	require.LessOrEqual(t, runtime.NumGoroutine(), baseline)
+}
@@ -4,6 +4,8 @@
 
 package compiler
 
+import "context"
+
 type semaphore struct {
 	x chan bool
 }
@@ -17,6 +19,17 @@ func (self *semaphore) Lock() {
 	self.x <- false
 }
 
+// LockContext is like Lock but gives up, without acquiring the semaphore, if
+// the context is done first.
+func (self *semaphore) LockContext(ctx context.Context) error {
+	select {
+	case self.x <- false:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 func (self *semaphore) Unlock() {
 	<-self.x
 }
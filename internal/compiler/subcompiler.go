@@ -16,20 +16,29 @@ type SubCompiler interface {
 	CompileFile(ctx context.Context, r exc.Reporter, file idl.File, dumpTokens bool, dumpTree bool) (*proto.Module, error)
 }
 
+// SubCompilerMulti is implemented by sub-compilers for file formats that may
+// contain more than one module, such as a protobuf FileDescriptorSet. The
+// compiler prefers CompileFileModules over CompileFile when it is available.
+type SubCompilerMulti interface {
+	SubCompiler
+	CompileFileModules(ctx context.Context, r exc.Reporter, file idl.File, dumpTokens bool, dumpTree bool) ([]*proto.Module, error)
+}
+
 func DefaultSubCompilers() map[idl.FileKind]SubCompiler {
 	scmicroglot := &SubCompilerMicroglot{}
 	scproto := &SubCompilerProtobuf{}
+	scprotodesc := &SubCompilerProtobufDesc{}
+	scimage := &SubCompilerImage{}
 	scidl := &SubCompilerIDL{
 		Microglot: scmicroglot,
 		Protobuf:  scproto,
 	}
 	return map[idl.FileKind]SubCompiler{
-		idl.FileKindMicroglot: scidl,
-		idl.FileKindProtobuf:  scproto,
-		// TODO: Add deserializer support for the encoded file formats below.
-		idl.FileKindMicroglotDescBinary: nil,
-		idl.FileKindMicroglotDescJSON:   nil,
-		idl.FileKindMicroglotDescProto:  nil,
-		idl.FileKindProtobufDesc:        nil,
+		idl.FileKindMicroglot:           scidl,
+		idl.FileKindProtobuf:            scproto,
+		idl.FileKindMicroglotDescBinary: scimage,
+		idl.FileKindMicroglotDescJSON:   scimage,
+		idl.FileKindMicroglotDescProto:  scimage,
+		idl.FileKindProtobufDesc:        scprotodesc,
 	}
 }
@@ -37,8 +37,8 @@ func (self *SubCompilerIDL) CompileFile(ctx context.Context, r exc.Reporter, fil
 READLOOP:
 	for tok := tokenLookahead.Next(ctx); tok.IsPresent(); tok = tokenLookahead.Next(ctx) {
 		t := tok.Value()
-		switch t.Type {
-		case idl.TokenTypeKeywordSyntax:
+		switch {
+		case t.Type == idl.TokenTypeKeywordSyntax, t.Type == idl.TokenTypeIdentifier && t.Value == "edition":
 			nt := tokenLookahead.Lookahead(ctx, 1)
 			if !nt.IsPresent() || nt.Value().Type != idl.TokenTypeEqual {
 				return nil, r.Report(exc.New(exc.Location{URI: file.Path(ctx), Location: *t.Span.Start}, exc.CodeUnsupportedFileFormat, "missing or invalid syntax statement"))
@@ -47,14 +47,20 @@ READLOOP:
 			if !nt.IsPresent() || nt.Value().Type != idl.TokenTypeText {
 				return nil, r.Report(exc.New(exc.Location{URI: file.Path(ctx), Location: *t.Span.Start}, exc.CodeUnsupportedFileFormat, "missing or invalid syntax statement"))
 			}
-			syntax = nt.Value().Value
+			if t.Type == idl.TokenTypeIdentifier {
+				// Any edition is left to the protobuf sub-compiler, which
+				// reports the editions that it doesn't support.
+				syntax = "editions"
+			} else {
+				syntax = nt.Value().Value
+			}
 			break READLOOP
 		}
 	}
 
 	_ = tokenLookahead.Close(ctx)
 	switch syntax {
-	case "proto2", "proto3":
+	case "proto2", "proto3", "editions":
 		return self.Protobuf.CompileFile(ctx, r, file, dumpTokens, dumpTree)
 	case "mglot0", "mglot1":
 		return self.Microglot.CompileFile(ctx, r, file, dumpTokens, dumpTree)
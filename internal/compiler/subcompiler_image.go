@@ -0,0 +1,65 @@
+// © 2023 Microglot LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+
+	"gopkg.microglot.org/mglotc/internal/exc"
+	"gopkg.microglot.org/mglotc/internal/idl"
+	"gopkg.microglot.org/mglotc/internal/proto"
+)
+
+// SubCompilerImage loads a previously compiled image in any of the microglot
+// image formats. The contained modules are already complete and linked, which
+// allows them to be imported without access to their original source. Images
+// also contain the built-in Protobuf module of the compiler that wrote them,
+// which is left out in favor of this compiler's own.
+type SubCompilerImage struct{}
+
+// CompileFile returns the first module in the image. Use CompileFileModules to
+// access all of the contained modules.
+func (self *SubCompilerImage) CompileFile(ctx context.Context, r exc.Reporter, file idl.File, dumpTokens bool, dumpTree bool) (*proto.Module, error) {
+	modules, err := self.CompileFileModules(ctx, r, file, dumpTokens, dumpTree)
+	if err != nil {
+		return nil, err
+	}
+	return modules[0], nil
+}
+
+func (self *SubCompilerImage) CompileFileModules(ctx context.Context, r exc.Reporter, file idl.File, dumpTokens bool, dumpTree bool) ([]*proto.Module, error) {
+	if dumpTokens {
+		return nil, errors.New("token stream dumping isn't supported for images")
+	}
+	b, err := file.Body(ctx)
+	if err != nil {
+		return nil, r.Report(exc.WrapUnknown(exc.Location{URI: file.Path(ctx)}, err))
+	}
+	defer b.Close(ctx)
+	raw, err := io.ReadAll(&fileBodyIO{ctx: ctx, body: b})
+	if err != nil {
+		return nil, r.Report(exc.WrapUnknown(exc.Location{URI: file.Path(ctx)}, err))
+	}
+	image, err := idl.UnmarshalImage(file.Kind(ctx), raw)
+	if err != nil {
+		return nil, r.Report(exc.Wrap(exc.Location{URI: file.Path(ctx)}, exc.CodeUnsupportedFileFormat, err))
+	}
+	image.Modules = slices.DeleteFunc(image.Modules, func(module *proto.Module) bool {
+		return module.URI == protobufURI
+	})
+	if len(image.Modules) < 1 {
+		return nil, r.Report(exc.New(exc.Location{URI: file.Path(ctx)}, exc.CodeUnsupportedFileFormat, "image contains no modules"))
+	}
+	if dumpTree {
+		for _, module := range image.Modules {
+			fmt.Println(module)
+		}
+	}
+	return image.Modules, nil
+}
@@ -45,12 +45,14 @@ func (self *SubCompilerMicroglot) CompileFile(ctx context.Context, r exc.Reporte
 		return nil, err
 	}
 	ast := p.ParseModule()
-	if ast == nil {
-		return nil, errors.New("parse failure")
-	}
 	if dumpTree {
 		fmt.Println(ast)
 	}
+	if p.Failed() {
+		// the individual errors have already been reported; the AST is
+		// incomplete so there's no point in converting it.
+		return nil, errors.New("parse failure")
+	}
 
 	module, err := microglot.FromModule(ast)
 	if err != nil {
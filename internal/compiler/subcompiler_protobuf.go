@@ -53,7 +53,7 @@ func (self *SubCompilerProtobuf) CompileFile(ctx context.Context, r exc.Reporter
 
 	result.FileDescriptorProto().SourceCodeInfo = sourceinfo.GenerateSourceInfo(result.AST(), optsIndex)
 
-	module, err := protobuf.FromFileDescriptorProto(result.FileDescriptorProto())
+	module, err := protobuf.FromParseResult(result)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,82 @@
+// © 2023 Microglot LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	pb "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"gopkg.microglot.org/mglotc/internal/compiler/protobuf"
+	"gopkg.microglot.org/mglotc/internal/exc"
+	"gopkg.microglot.org/mglotc/internal/idl"
+	"gopkg.microglot.org/mglotc/internal/proto"
+	"gopkg.microglot.org/mglotc/internal/target"
+)
+
+// SubCompilerProtobufDesc converts a binary encoded FileDescriptorSet into one
+// module for each contained FileDescriptorProto.
+type SubCompilerProtobufDesc struct{}
+
+// CompileFile returns the module for the last file in the set. By protoc
+// convention, that is the file that was requested when the set was built and
+// any preceding files are its dependencies.
+func (self *SubCompilerProtobufDesc) CompileFile(ctx context.Context, r exc.Reporter, file idl.File, dumpTokens bool, dumpTree bool) (*proto.Module, error) {
+	modules, err := self.CompileFileModules(ctx, r, file, dumpTokens, dumpTree)
+	if err != nil {
+		return nil, err
+	}
+	return modules[len(modules)-1], nil
+}
+
+func (self *SubCompilerProtobufDesc) CompileFileModules(ctx context.Context, r exc.Reporter, file idl.File, dumpTokens bool, dumpTree bool) ([]*proto.Module, error) {
+	if dumpTokens {
+		return nil, errors.New("token stream dumping isn't supported for descriptor sets")
+	}
+	b, err := file.Body(ctx)
+	if err != nil {
+		return nil, r.Report(exc.WrapUnknown(exc.Location{URI: file.Path(ctx)}, err))
+	}
+	defer b.Close(ctx)
+	raw, err := io.ReadAll(&fileBodyIO{ctx: ctx, body: b})
+	if err != nil {
+		return nil, r.Report(exc.WrapUnknown(exc.Location{URI: file.Path(ctx)}, err))
+	}
+	set := &descriptorpb.FileDescriptorSet{}
+	if err := pb.Unmarshal(raw, set); err != nil {
+		return nil, r.Report(exc.Wrap(exc.Location{URI: file.Path(ctx)}, exc.CodeUnsupportedFileFormat, err))
+	}
+	if len(set.File) < 1 {
+		return nil, r.Report(exc.New(exc.Location{URI: file.Path(ctx)}, exc.CodeUnsupportedFileFormat, "descriptor set contains no files"))
+	}
+	if dumpTree {
+		for _, fdp := range set.File {
+			fmt.Println(fdp)
+		}
+	}
+
+	modules := make([]*proto.Module, 0, len(set.File))
+	for _, fdp := range set.File {
+		if fdp.Name == nil {
+			return nil, r.Report(exc.New(exc.Location{URI: file.Path(ctx)}, exc.CodeUnsupportedFileFormat, "descriptor set contains a file without a name"))
+		}
+		// Descriptor sets record file names relative to the protoc include
+		// path. Normalizing them here gives the resulting modules the same URI
+		// and UID as if they were compiled from source, which is what allows
+		// imports to resolve against them.
+		name := target.Normalize(fdp.GetName())
+		fdp.Name = &name
+		module, err := protobuf.FromFileDescriptorProto(fdp)
+		if err != nil {
+			return nil, r.Report(exc.Wrap(exc.Location{URI: file.Path(ctx)}, exc.CodeUnsupportedFileFormat, err))
+		}
+		modules = append(modules, module)
+	}
+	return modules, nil
+}
@@ -12,12 +12,17 @@ import (
 	"sync"
 
 	"gopkg.microglot.org/mglotc/internal/exc"
+	"gopkg.microglot.org/mglotc/internal/idl"
 	"gopkg.microglot.org/mglotc/internal/proto"
+	"gopkg.microglot.org/mglotc/internal/target"
 )
 
 type moduleMeta struct {
 	uid             uint64
 	protobufPackage string
+	// publicImports are the imports whose declarations the module
+	// re-exports to the modules that import it.
+	publicImports []string
 }
 
 type globalSymbolTable struct {
@@ -26,6 +31,12 @@ type globalSymbolTable struct {
 	types      map[string]map[string]proto.TypeReference
 	attributes map[string]map[string]map[string]proto.AttributeReference
 	inputs     map[string]map[string]map[string]map[string]proto.SDKInputReference
+	// typeLocations records where each type was declared, which is needed to
+	// report collisions against the module that was collected first.
+	typeLocations map[string]map[string]*proto.SourceLocation
+	// nestedTypes maps the protobuf names of nested types, relative to their
+	// package (e.g. "Outer.Inner"), to the types that they were promoted to.
+	nestedTypes map[string]map[string]proto.TypeReference
 }
 
 // globalSymbolTable.collect() populates a symbol table with the symbols in a given descriptor
@@ -46,6 +57,12 @@ func (s *globalSymbolTable) collect(parsed proto.Module, r exc.Reporter) error {
 	if s.inputs == nil {
 		s.inputs = make(map[string]map[string]map[string]map[string]proto.SDKInputReference)
 	}
+	if s.typeLocations == nil {
+		s.typeLocations = make(map[string]map[string]*proto.SourceLocation)
+	}
+	if s.nestedTypes == nil {
+		s.nestedTypes = make(map[string]map[string]proto.TypeReference)
+	}
 
 	if s.types[parsed.URI] == nil {
 		s.types[parsed.URI] = make(map[string]proto.TypeReference)
@@ -75,64 +92,79 @@ func (s *globalSymbolTable) collect(parsed proto.Module, r exc.Reporter) error {
 		return errors.New("collect error")
 	}
 
+	// Modules are collected in whatever order they finish compiling, so
+	// collisions between modules are always reported against the module with
+	// the greater URI. That keeps the reported errors the same from run to run.
 	for moduleURI, moduleMeta := range s.modules {
 		if moduleMeta.uid == parsed.UID {
+			first, second := moduleURI, parsed.URI
+			if second < first {
+				first, second = second, first
+			}
 			_ = r.Report(exc.New(exc.Location{
-				URI: parsed.URI,
+				URI: second,
 				// TODO 2023.09.14: getting Location here would be nice!
-			}, exc.CodeUIDCollision, fmt.Sprintf("module UID '%d' is already in-use by '%s'", parsed.UID, moduleURI)))
-			return errors.New("collect error")
+			}, exc.CodeUIDCollision, fmt.Sprintf("module UID '%d' is already in-use by '%s'", parsed.UID, first)))
+		}
+	}
+	var publicImports []string
+	for _, import_ := range parsed.Imports {
+		if import_.IsPublic {
+			publicImports = append(publicImports, target.Normalize(import_.ImportedURI))
 		}
 	}
 	s.modules[parsed.URI] = moduleMeta{
 		uid:             parsed.UID,
 		protobufPackage: parsed.ProtobufPackage,
+		publicImports:   publicImports,
 	}
+	s.typeLocations[parsed.URI] = make(map[string]*proto.SourceLocation)
 
 	typeUIDs := make(map[uint64]string)
 
 	for _, struct_ := range parsed.Structs {
-		s.addType(r, parsed.URI, struct_.Name.Name, struct_.Reference, typeUIDs)
+		s.addType(r, parsed.URI, struct_.Name.Name, struct_.Reference, struct_.Location, typeUIDs)
 
 		attributeUIDs := make(map[uint64]string)
 		for _, field := range struct_.Fields {
-			s.addAttribute(r, parsed.URI, struct_.Name.Name, field.Name, field.Reference, attributeUIDs)
+			s.addAttribute(r, parsed.URI, struct_.Name.Name, field.Name, field.Reference, field.Location, attributeUIDs)
 		}
 		for _, union := range struct_.Unions {
-			s.addAttribute(r, parsed.URI, struct_.Name.Name, union.Name, union.Reference, attributeUIDs)
+			s.addAttribute(r, parsed.URI, struct_.Name.Name, union.Name, union.Reference, union.Location, attributeUIDs)
 		}
 	}
 	for _, enum := range parsed.Enums {
-		s.addType(r, parsed.URI, enum.Name, enum.Reference, typeUIDs)
+		s.addType(r, parsed.URI, enum.Name, enum.Reference, enum.Location, typeUIDs)
 		attributeUIDs := make(map[uint64]string)
 		for _, enumerant := range enum.Enumerants {
-			s.addAttribute(r, parsed.URI, enum.Name, enumerant.Name, enumerant.Reference, attributeUIDs)
+			s.addAttribute(r, parsed.URI, enum.Name, enumerant.Name, enumerant.Reference, enumerant.Location, attributeUIDs)
 		}
 	}
 	for _, api := range parsed.APIs {
-		s.addType(r, parsed.URI, api.Name.Name, api.Reference, typeUIDs)
+		s.addType(r, parsed.URI, api.Name.Name, api.Reference, api.Location, typeUIDs)
 		attributeUIDs := make(map[uint64]string)
 		for _, apiMethod := range api.Methods {
-			s.addAttribute(r, parsed.URI, api.Name.Name, apiMethod.Name, apiMethod.Reference, attributeUIDs)
+			s.addAttribute(r, parsed.URI, api.Name.Name, apiMethod.Name, apiMethod.Reference, apiMethod.Location, attributeUIDs)
 		}
 	}
 	for _, sdk := range parsed.SDKs {
-		s.addType(r, parsed.URI, sdk.Name.Name, sdk.Reference, typeUIDs)
+		s.addType(r, parsed.URI, sdk.Name.Name, sdk.Reference, sdk.Location, typeUIDs)
 		attributeUIDs := make(map[uint64]string)
 		for _, sdkMethod := range sdk.Methods {
-			s.addAttribute(r, parsed.URI, sdk.Name.Name, sdkMethod.Name, sdkMethod.Reference, attributeUIDs)
+			s.addAttribute(r, parsed.URI, sdk.Name.Name, sdkMethod.Name, sdkMethod.Reference, sdkMethod.Location, attributeUIDs)
 			sdkMethodInputUIDs := make(map[uint64]string)
 			for _, sdkMethodInput := range sdkMethod.Input {
-				s.addSDKMethodInput(r, parsed.URI, sdk.Name.Name, sdkMethod.Name, sdkMethodInput.Name, sdkMethodInput.Reference, sdkMethodInputUIDs)
+				s.addSDKMethodInput(r, parsed.URI, sdk.Name.Name, sdkMethod.Name, sdkMethodInput.Name, sdkMethodInput.Reference, sdkMethodInput.Location, sdkMethodInputUIDs)
 			}
 		}
 	}
 	for _, annotation := range parsed.Annotations {
-		s.addType(r, parsed.URI, annotation.Name, annotation.Reference, typeUIDs)
+		s.addType(r, parsed.URI, annotation.Name, annotation.Reference, annotation.Location, typeUIDs)
 	}
 	for _, constant := range parsed.Constants {
-		s.addType(r, parsed.URI, constant.Name, constant.Reference, typeUIDs)
+		s.addType(r, parsed.URI, constant.Name, constant.Reference, constant.Location, typeUIDs)
 	}
+	s.addNestedTypes(parsed)
 
 	if len(r.Reported()) > 0 {
 		return errors.New("collect error")
@@ -140,22 +172,48 @@ func (s *globalSymbolTable) collect(parsed proto.Module, r exc.Reporter) error {
 	return nil
 }
 
-func (s *globalSymbolTable) addType(r exc.Reporter, moduleURI string, name string, typeReference *proto.TypeReference, typeUIDs map[uint64]string) {
+// addNestedTypes records the protobuf names of the nested types of a module,
+// which are promoted to the top level of the module when it is converted, so
+// that they can be referenced by those names from other messages.
+func (s *globalSymbolTable) addNestedTypes(parsed proto.Module) {
+	// Assumes we're already holding s.lock!
+
+	type nesting struct {
+		parent string
+		name   string
+	}
+	parents := make(map[string]nesting)
+	for _, struct_ := range parsed.Structs {
+		for nestedName, promotedName := range idl.GetPromotedSymbolTable(struct_.AnnotationApplications) {
+			parents[promotedName] = nesting{struct_.Name.Name, nestedName}
+		}
+	}
+	var protobufName func(name string) string
+	protobufName = func(name string) string {
+		parent, ok := parents[name]
+		if !ok {
+			return name
+		}
+		return protobufName(parent.parent) + "." + parent.name
+	}
+	s.nestedTypes[parsed.URI] = make(map[string]proto.TypeReference, len(parents))
+	for promotedName := range parents {
+		if reference, ok := s.types[parsed.URI][promotedName]; ok {
+			s.nestedTypes[parsed.URI][protobufName(promotedName)] = reference
+		}
+	}
+}
+
+func (s *globalSymbolTable) addType(r exc.Reporter, moduleURI string, name string, typeReference *proto.TypeReference, location *proto.SourceLocation, typeUIDs map[uint64]string) {
 	// Assumes we're already holding s.lock!
 
 	if _, ok := typeUIDs[typeReference.TypeUID]; ok {
-		_ = r.Report(exc.New(exc.Location{
-			URI: moduleURI,
-			// TODO 2023.09.14: getting Location here would be nice!
-		}, exc.CodeUIDCollision, fmt.Sprintf("there is already a type with the uid '%d' in '%s'", typeReference.TypeUID, typeUIDs[typeReference.TypeUID])))
+		_ = r.Report(exc.New(sourceLocation(moduleURI, location), exc.CodeUIDCollision, fmt.Sprintf("there is already a type with the uid '%d' in '%s'", typeReference.TypeUID, typeUIDs[typeReference.TypeUID])))
 	}
 	typeUIDs[typeReference.TypeUID] = moduleURI
 
 	if _, ok := s.types[moduleURI][name]; ok {
-		_ = r.Report(exc.New(exc.Location{
-			URI: moduleURI,
-			// TODO 2023.09.12: getting Location here would be nice!
-		}, exc.CodeNameCollision, fmt.Sprintf("there is already a type named '%s' in '%s'", name, moduleURI)))
+		_ = r.Report(exc.New(sourceLocation(moduleURI, location), exc.CodeNameCollision, fmt.Sprintf("there is already a type named '%s' in '%s'", name, moduleURI)))
 	}
 
 	// We consider it an error to have the more than one declaration of the same typename in a given
@@ -166,25 +224,24 @@ func (s *globalSymbolTable) addType(r exc.Reporter, moduleURI string, name strin
 	for uri, meta := range s.modules {
 		if meta.protobufPackage == s.modules[moduleURI].protobufPackage {
 			if _, ok := s.types[uri][name]; ok {
-				_ = r.Report(exc.New(exc.Location{
-					URI: moduleURI,
-					// TODO 2023.11.01: getting Location here would be nice!
-				}, exc.CodeNameCollision, fmt.Sprintf("there is already a declaration of '%s.%s' in '%s'", meta.protobufPackage, name, uri)))
+				if uri <= moduleURI {
+					_ = r.Report(exc.New(sourceLocation(moduleURI, location), exc.CodeNameCollision, fmt.Sprintf("there is already a declaration of '%s.%s' in '%s'", meta.protobufPackage, name, uri)))
+				} else {
+					_ = r.Report(exc.New(sourceLocation(uri, s.typeLocations[uri][name]), exc.CodeNameCollision, fmt.Sprintf("there is already a declaration of '%s.%s' in '%s'", meta.protobufPackage, name, moduleURI)))
+				}
 			}
 		}
 	}
 
 	s.types[moduleURI][name] = *typeReference
+	s.typeLocations[moduleURI][name] = location
 }
 
-func (s *globalSymbolTable) addAttribute(r exc.Reporter, moduleURI string, typeName string, name string, attributeReference *proto.AttributeReference, attributeUIDs map[uint64]string) {
+func (s *globalSymbolTable) addAttribute(r exc.Reporter, moduleURI string, typeName string, name string, attributeReference *proto.AttributeReference, location *proto.SourceLocation, attributeUIDs map[uint64]string) {
 	// Assumes we're already holding s.lock!
 
 	if _, ok := attributeUIDs[attributeReference.AttributeUID]; ok {
-		_ = r.Report(exc.New(exc.Location{
-			URI: moduleURI,
-			// TODO 2023.09.14: getting Location here would be nice!
-		}, exc.CodeUIDCollision, fmt.Sprintf("there is already an attribute with the uid '%d' in '%s'", attributeReference.AttributeUID, attributeUIDs[attributeReference.AttributeUID])))
+		_ = r.Report(exc.New(sourceLocation(moduleURI, location), exc.CodeUIDCollision, fmt.Sprintf("there is already an attribute with the uid '%d' in '%s'", attributeReference.AttributeUID, attributeUIDs[attributeReference.AttributeUID])))
 	}
 	attributeUIDs[attributeReference.AttributeUID] = typeName
 
@@ -193,23 +250,17 @@ func (s *globalSymbolTable) addAttribute(r exc.Reporter, moduleURI string, typeN
 	}
 
 	if _, ok := s.attributes[moduleURI][typeName][name]; ok {
-		_ = r.Report(exc.New(exc.Location{
-			URI: moduleURI,
-			// TODO 2023.09.12: getting Location here would be nice!
-		}, exc.CodeNameCollision, fmt.Sprintf("there is already an attribute named '%s' in '%s'", name, typeName)))
+		_ = r.Report(exc.New(sourceLocation(moduleURI, location), exc.CodeNameCollision, fmt.Sprintf("there is already an attribute named '%s' in '%s'", name, typeName)))
 	} else {
 		s.attributes[moduleURI][typeName][name] = *attributeReference
 	}
 }
 
-func (s *globalSymbolTable) addSDKMethodInput(r exc.Reporter, moduleURI string, typeName string, sdkMethodName string, name string, sdkInputReference *proto.SDKInputReference, sdkMethodInputUIDs map[uint64]string) {
+func (s *globalSymbolTable) addSDKMethodInput(r exc.Reporter, moduleURI string, typeName string, sdkMethodName string, name string, sdkInputReference *proto.SDKInputReference, location *proto.SourceLocation, sdkMethodInputUIDs map[uint64]string) {
 	// Assumes we're already holding s.lock!
 
 	if _, ok := sdkMethodInputUIDs[sdkInputReference.AttributeUID]; ok {
-		_ = r.Report(exc.New(exc.Location{
-			URI: moduleURI,
-			// TODO 2023.09.14: getting Location here would be nice!
-		}, exc.CodeUIDCollision, fmt.Sprintf("there is already a method input with the uid '%d' in '%s'", sdkInputReference.AttributeUID, sdkMethodInputUIDs[sdkInputReference.AttributeUID])))
+		_ = r.Report(exc.New(sourceLocation(moduleURI, location), exc.CodeUIDCollision, fmt.Sprintf("there is already a method input with the uid '%d' in '%s'", sdkInputReference.AttributeUID, sdkMethodInputUIDs[sdkInputReference.AttributeUID])))
 	}
 	sdkMethodInputUIDs[sdkInputReference.InputUID] = typeName
 
@@ -221,16 +272,69 @@ func (s *globalSymbolTable) addSDKMethodInput(r exc.Reporter, moduleURI string,
 	}
 
 	if _, ok := s.inputs[moduleURI][typeName][sdkMethodName][name]; ok {
-		_ = r.Report(exc.New(exc.Location{
-			URI: moduleURI,
-			// TODO 2023.09.12: getting Location here would be nice!
-		}, exc.CodeNameCollision, fmt.Sprintf("there is already a method input named '%s' in '%s'", name, typeName)))
+		_ = r.Report(exc.New(sourceLocation(moduleURI, location), exc.CodeNameCollision, fmt.Sprintf("there is already a method input named '%s' in '%s'", name, typeName)))
 	} else {
 		s.inputs[moduleURI][typeName][sdkMethodName][name] = *sdkInputReference
 	}
 }
 
+// reexported returns the modules whose declarations the given module
+// re-exports, which are its public imports and, transitively, theirs. They are
+// sorted by URI.
+func (s *globalSymbolTable) reexported(uri string) []string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	seen := map[string]bool{uri: true}
+	var reexported []string
+	stack := slices.Clone(s.modules[uri].publicImports)
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if seen[current] {
+			continue
+		}
+		seen[current] = true
+		reexported = append(reexported, current)
+		stack = append(stack, s.modules[current].publicImports...)
+	}
+	slices.Sort(reexported)
+	return reexported
+}
+
+// dotImports returns the types that the given module re-exports, which appear
+// to the modules that import it as if the module declared them. They are sorted
+// by URI and then by name.
+func (s *globalSymbolTable) dotImports(uri string) []*proto.DotImport {
+	reexported := s.reexported(uri)
+
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	var dotImports []*proto.DotImport
+	for _, reexported := range reexported {
+		names := make([]string, 0, len(s.types[reexported]))
+		for name := range s.types[reexported] {
+			names = append(names, name)
+		}
+		slices.Sort(names)
+		for _, name := range names {
+			reference := s.types[reexported][name]
+			dotImports = append(dotImports, &proto.DotImport{
+				Name:      name,
+				Reference: &reference,
+			})
+		}
+	}
+	return dotImports
+}
+
+// packageSearch resolves a protobuf type name relative to the package pkg,
+// searching every module in the table.
 func (s *globalSymbolTable) packageSearch(pkg string, name string) (proto.TypeReference, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
 	// build segmentPackages, which is the list of packages to search, i.e. if the current package is
 	// "outer.inner", it will be ["", "outer", "outer.inner"]
 	segmentPackage := ""
@@ -251,33 +355,36 @@ func (s *globalSymbolTable) packageSearch(pkg string, name string) (proto.TypeRe
 		name = name[1:]
 	}
 
-	// now divide the name into qualifier and base
+	// and search, in segmentPackages order! Within each package, the name is
+	// divided into a qualifier, which extends the package, and a base, which is
+	// either a type or the path to a nested type, e.g. "Outer.Inner".
 	nameSegments := strings.Split(name, ".")
-	qualifier := ""
-	base := nameSegments[len(nameSegments)-1]
-	if len(nameSegments) > 1 {
-		qualifier = strings.Join(nameSegments[0:len(nameSegments)-1], ".")
-	}
-
-	// and search, in segmentPackages order!
 	for _, segmentPackage := range segmentPackages {
-		// fullPackage is the segmentPackage plus the qualifier part of the name
-		fullPackage := segmentPackage
-		if qualifier != "" {
-			if fullPackage != "" {
-				fullPackage = fullPackage + "." + qualifier
-			} else {
-				fullPackage = qualifier
+		for split := len(nameSegments) - 1; split >= 0; split = split - 1 {
+			qualifier := strings.Join(nameSegments[0:split], ".")
+			base := strings.Join(nameSegments[split:], ".")
+
+			// fullPackage is the segmentPackage plus the qualifier part of the name
+			fullPackage := segmentPackage
+			if qualifier != "" {
+				if fullPackage != "" {
+					fullPackage = fullPackage + "." + qualifier
+				} else {
+					fullPackage = qualifier
+				}
 			}
-		}
 
-		// look for exact package matches
-		for uri, meta := range s.modules {
-			if meta.protobufPackage == fullPackage {
-				// we're in a matching package. Is 'base' in the type symbol table?
-				sym, ok := s.types[uri][base]
-				if ok {
-					return sym, true
+			// look for exact package matches
+			for uri, meta := range s.modules {
+				if meta.protobufPackage == fullPackage {
+					// we're in a matching package. Is 'base' in the type symbol table?
+					sym, ok := s.types[uri][base]
+					if !ok {
+						sym, ok = s.nestedTypes[uri][base]
+					}
+					if ok {
+						return sym, true
+					}
 				}
 			}
 		}
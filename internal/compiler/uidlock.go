@@ -0,0 +1,270 @@
+// © 2023 Microglot LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"gopkg.microglot.org/mglotc/internal/exc"
+	"gopkg.microglot.org/mglotc/internal/idl"
+	"gopkg.microglot.org/mglotc/internal/proto"
+)
+
+const uidLockVersion = 1
+
+// UIDLock records the UIDs that the compiler generated for declarations that
+// don't have an explicit UID. Generated UIDs are derived from names, so
+// renaming a declaration would otherwise silently change its UID and, for
+// fields, its protobuf field number.
+//
+// Each UID is recorded by the URI of its module and the path of the
+// declaration within that module, such as "Foo" for a struct, "Foo.bar" for
+// one of its fields, or "Foo.method.input" for an SDK method input. When a
+// lock is given to the compiler then recorded UIDs are used in place of
+// generated ones and newly generated UIDs are added to the lock. A declaration
+// that is recorded in the lock but no longer declared, or that now has an
+// explicit UID that differs from the recorded one, is an error unless the lock
+// is being updated. Removed declarations whose UID or name is reserved are
+// dropped from the lock instead.
+type UIDLock struct {
+	lock    sync.Mutex
+	modules map[string]map[string]uint64
+	changed bool
+}
+
+type uidLockFile struct {
+	Version int                          `json:"version"`
+	Modules map[string]map[string]uint64 `json:"modules"`
+}
+
+// NewUIDLock returns an empty lock.
+func NewUIDLock() *UIDLock {
+	return &UIDLock{modules: make(map[string]map[string]uint64)}
+}
+
+// ParseUIDLock decodes a lock in the format produced by Marshal.
+func ParseUIDLock(b []byte) (*UIDLock, error) {
+	var f uidLockFile
+	if err := json.Unmarshal(b, &f); err != nil {
+		return nil, err
+	}
+	if f.Version != uidLockVersion {
+		return nil, fmt.Errorf("unsupported UID lock version %d", f.Version)
+	}
+	l := NewUIDLock()
+	for uri, paths := range f.Modules {
+		l.modules[uri] = paths
+	}
+	return l, nil
+}
+
+// Marshal encodes the lock. The output is stable for the same content so that
+// the lock can be kept under version control.
+func (l *UIDLock) Marshal() ([]byte, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	b, err := json.MarshalIndent(uidLockFile{Version: uidLockVersion, Modules: l.modules}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '\n'), nil
+}
+
+// Changed reports whether any compilation has modified the lock.
+func (l *UIDLock) Changed() bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return l.changed
+}
+
+// uidSlot is a UID of a declaration that the compiler generates when it isn't
+// given explicitly.
+type uidSlot struct {
+	path     string
+	uid      *uint64
+	location *proto.SourceLocation
+}
+
+// uidSlots returns every UID of the module's declarations that completeUIDs
+// would generate if it were missing.
+func uidSlots(module *proto.Module) []uidSlot {
+	var slots []uidSlot
+	add := func(path string, uid *uint64, location *proto.SourceLocation) {
+		slots = append(slots, uidSlot{path: path, uid: uid, location: location})
+	}
+	for _, struct_ := range module.Structs {
+		add(struct_.Name.Name, &struct_.Reference.TypeUID, struct_.Location)
+		for _, field := range struct_.Fields {
+			add(struct_.Name.Name+"."+field.Name, &field.Reference.AttributeUID, field.Location)
+		}
+		for _, union := range struct_.Unions {
+			add(struct_.Name.Name+"."+union.Name, &union.Reference.AttributeUID, union.Location)
+		}
+	}
+	for _, enum := range module.Enums {
+		add(enum.Name, &enum.Reference.TypeUID, enum.Location)
+		for _, enumerant := range enum.Enumerants {
+			add(enum.Name+"."+enumerant.Name, &enumerant.Reference.AttributeUID, enumerant.Location)
+		}
+	}
+	for _, api := range module.APIs {
+		add(api.Name.Name, &api.Reference.TypeUID, api.Location)
+		for _, apiMethod := range api.Methods {
+			add(api.Name.Name+"."+apiMethod.Name, &apiMethod.Reference.AttributeUID, apiMethod.Location)
+		}
+	}
+	for _, sdk := range module.SDKs {
+		add(sdk.Name.Name, &sdk.Reference.TypeUID, sdk.Location)
+		for _, sdkMethod := range sdk.Methods {
+			add(sdk.Name.Name+"."+sdkMethod.Name, &sdkMethod.Reference.AttributeUID, sdkMethod.Location)
+			for _, sdkMethodInput := range sdkMethod.Input {
+				add(sdk.Name.Name+"."+sdkMethod.Name+"."+sdkMethodInput.Name, &sdkMethodInput.Reference.InputUID, sdkMethodInput.Location)
+			}
+		}
+	}
+	for _, annotation := range module.Annotations {
+		add(annotation.Name, &annotation.Reference.TypeUID, annotation.Location)
+	}
+	for _, constant := range module.Constants {
+		add(constant.Name, &constant.Reference.TypeUID, constant.Location)
+	}
+	return slots
+}
+
+// apply sets every missing UID of the module that is recorded in the lock and
+// checks that no recorded UID has changed. When updating, changes are accepted
+// and the lock is corrected instead. It returns the slots whose UIDs must still
+// be generated, which should be passed to record once they have been.
+func (l *UIDLock) apply(module *proto.Module, update bool, r exc.Reporter) ([]uidSlot, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	recorded := l.modules[module.URI]
+	declared := make(map[string]bool)
+	// explicit contains the explicit UIDs of declarations, keyed by the path
+	// of their parent, so that a renamed declaration that keeps its recorded
+	// UID can be recognized.
+	explicit := make(map[string]map[uint64]bool)
+	var generated []uidSlot
+	var failed bool
+	for _, slot := range uidSlots(module) {
+		declared[slot.path] = true
+		if *slot.uid != idl.Incomplete {
+			parent := uidParentPath(slot.path)
+			if explicit[parent] == nil {
+				explicit[parent] = make(map[uint64]bool)
+			}
+			explicit[parent][*slot.uid] = true
+		}
+		uid, ok := recorded[slot.path]
+		switch {
+		case *slot.uid == idl.Incomplete && ok:
+			*slot.uid = uid
+		case *slot.uid == idl.Incomplete:
+			generated = append(generated, slot)
+		case ok && (update || *slot.uid == uid):
+			// The UID is now explicit so it no longer needs to be recorded.
+			delete(recorded, slot.path)
+			l.changed = true
+		case ok:
+			failed = true
+			_ = r.Report(exc.New(sourceLocation(module.URI, slot.location), exc.CodeUIDLockMismatch, fmt.Sprintf("the UID of %s is @%d but the UID lock records the generated UID @%d; update the lock if the change is intended", slot.path, *slot.uid, uid)))
+		}
+	}
+	stale := make([]string, 0, len(recorded))
+	for path := range recorded {
+		if !declared[path] {
+			stale = append(stale, path)
+		}
+	}
+	sort.Strings(stale)
+	reservations := uidReservations(module)
+	for _, path := range stale {
+		// A declaration that was removed and reserved, or renamed and given
+		// its recorded UID, needs no further attention.
+		parent := uidParentPath(path)
+		if update || explicit[parent][recorded[path]] || reservations[parent].covers(strings.TrimPrefix(path[len(parent):], "."), recorded[path]) {
+			delete(recorded, path)
+			l.changed = true
+			continue
+		}
+		failed = true
+		_ = r.Report(exc.New(exc.Location{URI: module.URI}, exc.CodeUIDLockMismatch, fmt.Sprintf("%s is no longer declared but the UID lock records its generated UID @%d; give a renamed declaration the explicit UID @%d, or update the lock if the change is intended", path, recorded[path], recorded[path])))
+	}
+	if len(recorded) == 0 {
+		delete(l.modules, module.URI)
+	}
+	if failed {
+		return nil, errors.New("UID lock mismatch")
+	}
+	return generated, nil
+}
+
+// uidReservation is the set of UIDs and names reserved by a declaration.
+type uidReservation struct {
+	ranges []*proto.ReservedRange
+	names  []string
+}
+
+func (r uidReservation) covers(name string, uid uint64) bool {
+	for _, reserved := range r.ranges {
+		if uid >= reserved.Start && uid <= reserved.End {
+			return true
+		}
+	}
+	for _, reserved := range r.names {
+		if name == reserved {
+			return true
+		}
+	}
+	return false
+}
+
+// uidReservations returns the reservations of every declaration in the module
+// that has any, keyed by the path of the declaration.
+func uidReservations(module *proto.Module) map[string]uidReservation {
+	reservations := make(map[string]uidReservation)
+	for _, struct_ := range module.Structs {
+		reservations[struct_.Name.Name] = uidReservation{struct_.Reserved, struct_.ReservedNames}
+	}
+	for _, enum := range module.Enums {
+		reservations[enum.Name] = uidReservation{enum.Reserved, enum.ReservedNames}
+	}
+	for _, api := range module.APIs {
+		reservations[api.Name.Name] = uidReservation{api.Reserved, api.ReservedNames}
+	}
+	for _, sdk := range module.SDKs {
+		reservations[sdk.Name.Name] = uidReservation{sdk.Reserved, sdk.ReservedNames}
+	}
+	return reservations
+}
+
+func uidParentPath(path string) string {
+	if x := strings.LastIndexByte(path, '.'); x >= 0 {
+		return path[:x]
+	}
+	return ""
+}
+
+// record adds the UIDs that were generated for the given slots to the lock.
+func (l *UIDLock) record(uri string, generated []uidSlot) {
+	if len(generated) < 1 {
+		return
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if l.modules[uri] == nil {
+		l.modules[uri] = make(map[string]uint64)
+	}
+	for _, slot := range generated {
+		l.modules[uri][slot.path] = *slot.uid
+	}
+	l.changed = true
+}
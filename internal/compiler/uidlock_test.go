@@ -0,0 +1,143 @@
+// © 2023 Microglot LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gopkg.microglot.org/mglotc/internal/exc"
+	"gopkg.microglot.org/mglotc/internal/idl"
+	"gopkg.microglot.org/mglotc/internal/proto"
+)
+
+func TestUIDLock(t *testing.T) {
+	t.Parallel()
+
+	compile := func(t *testing.T, lock *UIDLock, update bool, contents string) (*proto.Module, error) {
+		file := CompilerTestFile{kind: idl.FileKindMicroglot, uri: "/a.mglot", contents: contents}
+		c, err := New(OptionWithExcReporter(exc.NewReporter(nil)), OptionWithFS(newTestFS(file)), OptionWithUIDLock(lock, update))
+		require.NoError(t, err)
+		resp, err := c.Compile(context.Background(), &idl.CompileRequest{Files: []string{file.uri}})
+		if err != nil {
+			return nil, err
+		}
+		for _, module := range resp.Image.Modules {
+			if module.URI == file.uri {
+				return module, nil
+			}
+		}
+		require.FailNow(t, "module not found")
+		return nil, nil
+	}
+	fieldUID := func(module *proto.Module, name string) uint64 {
+		for _, field := range module.Structs[0].Fields {
+			if field.Name == name {
+				return field.Reference.AttributeUID
+			}
+		}
+		return idl.Incomplete
+	}
+	original := "syntax = \"mglot0\"\nmodule = @11\nstruct Foo {\n    bar :Text\n    baz :Text @2\n}\n"
+	renamed := "syntax = \"mglot0\"\nmodule = @11\nstruct Foo {\n    qux :Text\n    baz :Text @2\n}\n"
+
+	lock := NewUIDLock()
+	module, err := compile(t, lock, false, original)
+	require.NoError(t, err)
+	require.True(t, lock.Changed())
+	bar := fieldUID(module, "bar")
+	require.Equal(t, map[string]map[string]uint64{
+		"/a.mglot": {
+			"Foo":     module.Structs[0].Reference.TypeUID,
+			"Foo.bar": bar,
+		},
+	}, lock.modules)
+
+	// The lock survives being written out and read back in.
+	b, err := lock.Marshal()
+	require.NoError(t, err)
+	lock, err = ParseUIDLock(b)
+	require.NoError(t, err)
+	require.False(t, lock.Changed())
+	module, err = compile(t, lock, false, original)
+	require.NoError(t, err)
+	require.Equal(t, bar, fieldUID(module, "bar"))
+	require.False(t, lock.Changed())
+
+	t.Run("rename", func(t *testing.T) {
+		t.Parallel()
+		lock, err := ParseUIDLock(b)
+		require.NoError(t, err)
+		_, err = compile(t, lock, false, renamed)
+		require.Error(t, err)
+		var caught MultiException
+		require.ErrorAs(t, err, &caught)
+		require.Len(t, caught, 1)
+		require.Equal(t, exc.CodeUIDLockMismatch, caught[0].Code())
+		require.Contains(t, caught[0].Message(), "Foo.bar is no longer declared")
+	})
+
+	t.Run("changed explicit UID", func(t *testing.T) {
+		t.Parallel()
+		lock, err := ParseUIDLock(b)
+		require.NoError(t, err)
+		_, err = compile(t, lock, false, "syntax = \"mglot0\"\nmodule = @11\nstruct Foo {\n    bar :Text @3\n    baz :Text @2\n}\n")
+		require.Error(t, err)
+		var caught MultiException
+		require.ErrorAs(t, err, &caught)
+		require.Len(t, caught, 1)
+		require.Equal(t, exc.CodeUIDLockMismatch, caught[0].Code())
+		require.Equal(t, int32(4), caught[0].Location().Line)
+	})
+
+	t.Run("rename with the recorded UID", func(t *testing.T) {
+		t.Parallel()
+		lock, err := ParseUIDLock(b)
+		require.NoError(t, err)
+		_, err = compile(t, lock, false, "syntax = \"mglot0\"\nmodule = @11\nstruct Foo {\n    qux :Text @"+strconv.FormatUint(bar, 10)+"\n    baz :Text @2\n}\n")
+		require.NoError(t, err)
+	})
+
+	t.Run("removed and reserved", func(t *testing.T) {
+		t.Parallel()
+		for _, reserved := range []string{"@" + strconv.FormatUint(bar, 10), "\"bar\""} {
+			lock, err := ParseUIDLock(b)
+			require.NoError(t, err)
+			_, err = compile(t, lock, false, "syntax = \"mglot0\"\nmodule = @11\nstruct Foo {\n    reserved "+reserved+"\n    baz :Text @2\n}\n")
+			require.NoError(t, err)
+			require.Equal(t, map[string]uint64{"Foo": lock.modules["/a.mglot"]["Foo"]}, lock.modules["/a.mglot"])
+		}
+	})
+
+	t.Run("update", func(t *testing.T) {
+		t.Parallel()
+		lock, err := ParseUIDLock(b)
+		require.NoError(t, err)
+		module, err := compile(t, lock, true, renamed)
+		require.NoError(t, err)
+		require.True(t, lock.Changed())
+		require.Equal(t, map[string]uint64{
+			"Foo":     module.Structs[0].Reference.TypeUID,
+			"Foo.qux": fieldUID(module, "qux"),
+		}, lock.modules["/a.mglot"])
+	})
+
+	t.Run("protobuf", func(t *testing.T) {
+		t.Parallel()
+		lock := NewUIDLock()
+		for _, contents := range []string{"syntax = \"proto3\";\nmessage M {\n  int32 f = 1;\n}\n", "syntax = \"proto3\";\nmessage N {\n  int32 f = 1;\n}\n"} {
+			file := CompilerTestFile{kind: idl.FileKindProtobuf, uri: "/a.proto", contents: contents}
+			r := exc.NewReporter(nil)
+			c, err := New(OptionWithExcReporter(r), OptionWithFS(newTestFS(file)), OptionWithUIDLock(lock, false))
+			require.NoError(t, err)
+			_, err = c.Compile(context.Background(), &idl.CompileRequest{Files: []string{file.uri}})
+			require.NoError(t, err, r.Reported())
+			require.Empty(t, lock.modules)
+		}
+	})
+}
@@ -0,0 +1,95 @@
+// © 2023 Microglot LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"context"
+	"os"
+
+	"github.com/bufbuild/protocompile"
+	"github.com/bufbuild/protocompile/reporter"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"gopkg.microglot.org/mglotc/internal/exc"
+	"gopkg.microglot.org/mglotc/internal/fs"
+	"gopkg.microglot.org/mglotc/internal/idl"
+	"gopkg.microglot.org/mglotc/internal/target"
+)
+
+// validateProtobuf compiles the modules that were compiled from protobuf
+// sources once more with protocompile, whose linker and validation report the
+// problems that protoc rejects but that the compiler itself doesn't catch, such
+// as conflicting JSON names, enum values that collide under the C++ scoping
+// rules, or services that are used as field types.
+//
+// The files that the sources import are given to protocompile as the
+// descriptors that the image converts to, so protobuf sources may still import
+// native modules and modules from descriptor sets. Only the problems within
+// protobuf sources are reported, and protocompile's warnings are ignored as
+// they are by protoc.
+func (self *compiler) validateProtobuf(ctx context.Context, image *idl.Image) {
+	sources := make(map[string]idl.File)
+	var names []string
+	for _, module := range image.Modules {
+		if fs.KindOf(module.URI) != idl.FileKindProtobuf {
+			continue
+		}
+		// Modules from descriptor sets have protobuf URIs too, but have no
+		// source to open.
+		files, err := self.FS.Open(ctx, module.URI)
+		if err != nil || len(files) != 1 || files[0].Kind(ctx) != idl.FileKindProtobuf {
+			continue
+		}
+		name := idl.URIToProtoFile(module.URI)
+		sources[name] = files[0]
+		names = append(names, name)
+	}
+	if len(names) < 1 {
+		return
+	}
+	// Problems that protoc rejects, such as a service that is used as a field
+	// type, can also keep the image from being converted. Then the sources
+	// that import anything else can't be resolved, which protocompile returns
+	// rather than reports, so only the others are validated.
+	descriptors := make(map[string]*descriptorpb.FileDescriptorProto)
+	if set, err := image.ToFileDescriptorSet(); err == nil {
+		for _, file := range set.File {
+			descriptors[file.GetName()] = file
+		}
+	}
+
+	c := protocompile.Compiler{
+		Resolver: protocompile.WithStandardImports(protocompile.ResolverFunc(func(path string) (protocompile.SearchResult, error) {
+			if file, ok := sources[path]; ok {
+				body, err := file.Body(ctx)
+				if err != nil {
+					return protocompile.SearchResult{}, err
+				}
+				return protocompile.SearchResult{Source: &fileBodyIO{ctx: ctx, body: body}}, nil
+			}
+			if descriptor, ok := descriptors[path]; ok {
+				return protocompile.SearchResult{Proto: descriptor}, nil
+			}
+			return protocompile.SearchResult{}, os.ErrNotExist
+		})),
+		Reporter: reporter.NewReporter(func(e reporter.ErrorWithPos) error {
+			pos := e.GetPosition()
+			if _, ok := sources[pos.Filename]; !ok {
+				return nil
+			}
+			loc := exc.Location{
+				URI: target.Normalize(pos.Filename),
+				Location: idl.Location{
+					Line:   int32(pos.Line),
+					Column: int32(pos.Col),
+					Offset: int64(pos.Offset),
+				},
+			}
+			_ = self.Reporter.Report(exc.New(loc, exc.CodeProtobufValidationError, e.Unwrap().Error()))
+			return nil
+		}, nil),
+	}
+	_, _ = c.Compile(ctx, names...)
+}
@@ -12,6 +12,12 @@ func walkModule(module *proto.Module, f func(interface{})) {
 	for _, annotation := range module.AnnotationApplications {
 		walkAnnotationApplication(annotation, f)
 	}
+	// Extensions are walked before the structs so that the promoted names of
+	// the nested types of a struct, which the linker sets up as it walks into
+	// the struct, don't apply to them.
+	for _, extension := range module.Extensions {
+		walkExtension(extension, f)
+	}
 	for _, struct_ := range module.Structs {
 		walkStruct(struct_, f)
 	}
@@ -41,11 +47,20 @@ func walkStruct(struct_ *proto.Struct, f func(interface{})) {
 	for _, union := range struct_.Unions {
 		walkUnion(union, f)
 	}
+	for _, extension := range struct_.Extensions {
+		walkExtension(extension, f)
+	}
 	for _, annotation := range struct_.AnnotationApplications {
 		walkAnnotationApplication(annotation, f)
 	}
 }
 
+func walkExtension(extension *proto.Extension, f func(interface{})) {
+	walkTypeSpecifier(extension.Extendee, f)
+	walkField(extension.Field, f)
+	f(extension)
+}
+
 func walkField(field *proto.Field, f func(interface{})) {
 	if field.DefaultValue != nil {
 		walkValue(field.DefaultValue, f)
@@ -174,6 +189,19 @@ func walkValue(value *proto.Value, f func(interface{})) {
 		walkValue(v.Binary.Right, f)
 	case *proto.Value_Identifier:
 		f(v.Identifier)
+	case *proto.Value_List:
+		for _, element := range v.List.Elements {
+			walkValue(element, f)
+		}
+	case *proto.Value_Map:
+		for _, entry := range v.Map.Entries {
+			walkValue(entry.Key, f)
+			walkValue(entry.Value, f)
+		}
+	case *proto.Value_Struct:
+		for _, field := range v.Struct.Fields {
+			walkValue(field.Value, f)
+		}
 	}
 	f(value)
 }
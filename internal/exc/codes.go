@@ -28,6 +28,23 @@ const (
 	CodeWrongTypeValue                = "M0020"
 	CodeUnimplemented                 = "M0021"
 	CodeUnknownFieldInStructLiteral   = "M0022"
+	CodeImportCycle                   = "M0023"
+	CodeUIDLockMismatch               = "M0024"
+	CodeReservedUID                   = "M0025"
+	CodeReservedName                  = "M0026"
+	CodeInvalidUID                    = "M0027"
+	CodeAnnotationScope               = "M0028"
+	CodeExtendsCycle                  = "M0029"
+	CodeMethodCollision               = "M0030"
+	CodeInvalidOption                 = "M0031"
+	CodeExtensionCollision            = "M0032"
+	CodeInvalidFieldType              = "M0033"
+	CodeProtobufValidationError       = "M0034"
+	CodeUnsupportedBySyntax           = "M0035"
+	CodeInvalidListElement            = "M0036"
+	CodeInvalidMapKey                 = "M0037"
+	CodeInvalidMapValue               = "M0038"
+	CodeInvalidPresenceType           = "M0039"
 )
 
 const (
@@ -36,6 +36,12 @@ var knownExts = map[string]idl.FileKind{
 	protoDescExt:     idl.FileKindProtobufDesc,
 }
 
+// KindOf returns the FileKind associated with the extension of the given path
+// or FileKindNone if the extension is not recognized.
+func KindOf(path string) idl.FileKind {
+	return knownExts[filepath.Ext(path)]
+}
+
 var _ idl.FileSystem = FileSystemMulti{}
 
 // FileSystemMulti is an ordered set of FileSystem implementations that are
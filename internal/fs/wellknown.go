@@ -0,0 +1,60 @@
+// © 2023 Microglot LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fs
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/bufbuild/protocompile"
+	"github.com/bufbuild/protocompile/wellknownimports"
+
+	"gopkg.microglot.org/mglotc/internal/exc"
+	"gopkg.microglot.org/mglotc/internal/idl"
+)
+
+var _ idl.FileSystem = fileSystemWellKnown{}
+
+// fileSystemWellKnown serves the well-known protobuf files, such as
+// google/protobuf/descriptor.proto, that protoc makes available to every file
+// without them being present on disk.
+type fileSystemWellKnown struct {
+	resolver protocompile.Resolver
+}
+
+// NewFileSystemWellKnown returns a read-only FileSystem that contains the
+// well-known protobuf files. It is meant to be the last of a FileSystemMulti
+// so that files of the same name in other file systems take precedence.
+func NewFileSystemWellKnown() idl.FileSystem {
+	return fileSystemWellKnown{
+		resolver: wellknownimports.WithStandardImports(&protocompile.SourceResolver{}),
+	}
+}
+
+func (r fileSystemWellKnown) Open(ctx context.Context, uri string) ([]idl.File, error) {
+	path := uri
+	u, err := url.Parse(uri)
+	if err == nil {
+		path = u.Path
+	}
+	path = filepath.Clean(filepath.Join("/", path))
+	result, err := r.resolver.FindFileByPath(strings.TrimPrefix(path, "/"))
+	if err != nil || result.Source == nil {
+		return nil, exc.New(exc.Location{URI: uri}, exc.CodeFileNotFound, fmt.Sprintf("%s is not a well-known protobuf file", uri))
+	}
+	contents, err := io.ReadAll(result.Source)
+	if err != nil {
+		return nil, exc.WrapUnknown(exc.Location{URI: uri}, err)
+	}
+	return []idl.File{NewFileString(path, string(contents), KindOf(path))}, nil
+}
+
+func (r fileSystemWellKnown) Write(ctx context.Context, uri string, content string) error {
+	return exc.New(exc.Location{URI: uri}, exc.CodeUnsuportedFileSystemOperation, "cannot write to the well-known protobuf files")
+}
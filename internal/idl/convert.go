@@ -7,9 +7,14 @@ package idl
 import (
 	"errors"
 	"fmt"
+	"math"
+	"slices"
 	"sort"
 	"strings"
 
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/descriptorpb"
 
 	"gopkg.microglot.org/mglotc/internal/proto"
@@ -29,9 +34,25 @@ func (image *Image) ToFileDescriptorSet() (*descriptorpb.FileDescriptorSet, erro
 	return converter.convert()
 }
 
+// descriptorProtoFile is the file that declares the protobuf options messages,
+// which files that declare extensions of them depend on.
+const descriptorProtoFile = "google/protobuf/descriptor.proto"
+
 type imageConverter struct {
 	image *Image
 
+	// extendsOptions is set when any module declares an extension of the
+	// options messages, which means that the file that declares them must be
+	// included.
+	extendsOptions bool
+
+	// syntax is the syntax of the module that is being converted, which is
+	// proto2, proto3 or editions. Modules that use editions also have an
+	// edition, and the features that their annotations set for the file.
+	syntax       string
+	edition      descriptorpb.Edition
+	fileFeatures *descriptorpb.FeatureSet
+
 	// SourceCodeInfo is accumulated here, as side-effects of the main conversion.
 	p        *PathState
 	location []*descriptorpb.SourceCodeInfo_Location
@@ -66,6 +87,9 @@ func (c *imageConverter) convert() (*descriptorpb.FileDescriptorSet, error) {
 		}
 		files = append(files, file)
 	}
+	if c.extendsOptions && !slices.ContainsFunc(files, func(file *descriptorpb.FileDescriptorProto) bool { return file.GetName() == descriptorProtoFile }) {
+		files = append(files, protodesc.ToFileDescriptorProto(descriptorpb.File_google_protobuf_descriptor_proto))
+	}
 	files, err := c.topoSort(files)
 	return &descriptorpb.FileDescriptorSet{
 		File: files,
@@ -126,10 +150,18 @@ func (c *imageConverter) topoSort(files []*descriptorpb.FileDescriptorProto) ([]
 			}
 		}
 	}
-	// TODO 2024-03-01: Is it worth it to check for cycles and return an error
-	// here? This seems like something that should happen outside of conversion
-	// to proto. At the same time, I'm certain that microglot will have the same
-	// guarantee of pre-sorted files.
+	// Import cycles are reported by the compiler, with the full chain of
+	// imports, so this only guards against images that were built some other
+	// way. Any file that is part of a cycle never becomes a root.
+	if len(sorted) != len(files) {
+		var unsorted []string
+		for _, file := range files {
+			if !completed[file.GetName()] {
+				unsorted = append(unsorted, file.GetName())
+			}
+		}
+		return nil, fmt.Errorf("unable to order files with an import cycle: %s", strings.Join(unsorted, ", "))
+	}
 	return sorted, nil
 }
 
@@ -152,14 +184,24 @@ func mapFrom[F any, T any](c *imageConverter, in []*F, f func(*F) (T, error)) ([
 }
 
 func GetProtobufAnnotation(as []*proto.AnnotationApplication, name string) *proto.Value {
+	annotation := GetProtobufAnnotationApplication(as, name)
+	if annotation == nil {
+		return nil
+	}
+	return annotation.Value
+}
+
+// GetProtobufAnnotationApplication returns the application of the named
+// annotation of the Protobuf module, or nil if there isn't one.
+func GetProtobufAnnotationApplication(as []*proto.AnnotationApplication, name string) *proto.AnnotationApplication {
 	for _, annotation := range as {
 		resolvedReference, ok := annotation.Annotation.Reference.(*proto.TypeSpecifier_Resolved)
 		if !ok {
 			continue
 		}
 		typeReference := resolvedReference.Resolved.Reference
-		if typeReference.ModuleUID == 2 && typeReference.TypeUID == PROTOBUF_TYPE_UIDS[name] {
-			return annotation.Value
+		if typeReference.ModuleUID == ProtobufModuleUID && typeReference.TypeUID == PROTOBUF_TYPE_UIDS[name] {
+			return annotation
 		}
 	}
 	return nil
@@ -195,6 +237,20 @@ func GetPromotedSymbolTable(as []*proto.AnnotationApplication) map[string]string
 	return promotedSymbolTable
 }
 
+// getPromotedNames returns the original names of the nested types recorded by
+// GetPromotedSymbolTable, in the order that they were recorded.
+func getPromotedNames(as []*proto.AnnotationApplication) []string {
+	var names []string
+	nestedTypeInfo := GetProtobufAnnotation(as, "NestedTypeInfo")
+	if nestedTypeInfo != nil {
+		elements := nestedTypeInfo.Kind.(*proto.Value_Struct).Struct.Fields[0].Value.Kind.(*proto.Value_List).List.Elements
+		for _, element := range elements {
+			names = append(names, element.Kind.(*proto.Value_Struct).Struct.Fields[0].Value.Kind.(*proto.Value_Text).Text.Value)
+		}
+	}
+	return names
+}
+
 func (c *imageConverter) lookupStruct(moduleUID uint64, structName string) *proto.Struct {
 	for _, module := range c.image.Modules {
 		if module.UID == moduleUID {
@@ -221,13 +277,16 @@ func (c *imageConverter) lookupEnum(moduleUID uint64, enumName string) *proto.En
 	return nil
 }
 
+// getNestedName returns the name that a type that was promoted from a nested
+// type has in protobuf, relative to its package, e.g. "Outer.Inner". Other
+// types keep their name.
 func (c *imageConverter) getNestedName(moduleUID uint64, name string) string {
 	for _, module := range c.image.Modules {
 		if module.UID == moduleUID {
 			for _, struct_ := range module.Structs {
 				for nestedName, promotedName := range GetPromotedSymbolTable(struct_.AnnotationApplications) {
 					if promotedName == name {
-						return fmt.Sprintf(".%s.%s", struct_.Name.Name, nestedName)
+						return c.getNestedName(moduleUID, struct_.Name.Name) + "." + nestedName
 					}
 				}
 			}
@@ -252,18 +311,14 @@ func (c *imageConverter) getQualifiedName(protobufPackage string, moduleUID uint
 	//                   protocompile always produce fully qualified type names.
 	//                   This behavior is relied on by some of the official Go
 	//                   protobuf libraries. This method needs to be refactored
-	//                   to handle fully qualified nested type references and
-	//                   fully qualified map entry type references. It then
-	//                   needs to be used anywhere a TypeName reference is
+	//                   to handle fully qualified map entry type references. It
+	//                   then needs to be used anywhere a TypeName reference is
 	//                   created.
 	nestedName := c.getNestedName(moduleUID, name)
-	if nestedName != name {
-		return nestedName
-	}
 	if protobufPackage != "" {
-		return fmt.Sprintf(".%s.%s", protobufPackage, name)
+		return fmt.Sprintf(".%s.%s", protobufPackage, nestedName)
 	}
-	return fmt.Sprintf(".%s", name)
+	return fmt.Sprintf(".%s", nestedName)
 }
 
 func (c *imageConverter) fromModule(module *proto.Module) (*descriptorpb.FileDescriptorProto, error) {
@@ -272,9 +327,28 @@ func (c *imageConverter) fromModule(module *proto.Module) (*descriptorpb.FileDes
 	// lossy!
 
 	c.resetPathState()
+	syntax, edition, err := ProtobufSyntax(module)
+	if err != nil {
+		return nil, err
+	}
+	c.syntax = syntax
+	c.edition = edition
+	fileOptions := new(descriptorpb.FileOptions)
+	if err := ToOptions(fileOptions.ProtoReflect(), module.AnnotationApplications); err != nil {
+		return nil, err
+	}
+	c.fileFeatures = fileOptions.GetFeatures()
 
 	var dependencies []string
+	var publicDependencies []int32
+	var weakDependencies []int32
 	for _, import_ := range module.Imports {
+		if import_.IsPublic {
+			publicDependencies = append(publicDependencies, int32(len(dependencies)))
+		}
+		if import_.IsWeak {
+			weakDependencies = append(weakDependencies, int32(len(dependencies)))
+		}
 		dependencies = append(dependencies, URIToProtoFile(import_.ImportedURI))
 	}
 
@@ -314,7 +388,61 @@ func (c *imageConverter) fromModule(module *proto.Module) (*descriptorpb.FileDes
 		services = append(services, service)
 	}
 
-	syntax := "proto3"
+	// Annotations are converted to extensions of the protobuf options
+	// messages, which are emitted along with the extensions of structs in the
+	// order they were declared.
+	declarations := make([]interface{}, 0, len(module.Annotations)+len(module.Extensions))
+	for _, annotation := range module.Annotations {
+		declarations = append(declarations, annotation)
+	}
+	for _, extension := range module.Extensions {
+		declarations = append(declarations, extension)
+	}
+	slices.SortStableFunc(declarations, func(a interface{}, b interface{}) int {
+		return compareLocations(declarationLocation(a), declarationLocation(b))
+	})
+	c.p.PushFieldNumber( /* Extension */ 7)
+	c.p.PushIndex()
+	var extensions []*descriptorpb.FieldDescriptorProto
+	extendsOptions := false
+	for _, declaration := range declarations {
+		switch d := declaration.(type) {
+		case *proto.Annotation:
+			annotationExtensions, err := c.fromAnnotation(d)
+			if err != nil {
+				return nil, err
+			}
+			extensions = append(extensions, annotationExtensions...)
+			extendsOptions = extendsOptions || len(annotationExtensions) > 0
+		case *proto.Extension:
+			extension, err := c.fromExtension(d)
+			if err != nil {
+				return nil, err
+			}
+			extensions = append(extensions, extension)
+			c.p.IncrementIndex()
+		}
+	}
+	c.p.PopIndex()
+	c.p.PopFieldNumber()
+	// Annotations of microglot modules don't come from an import of the file
+	// that declares the options messages, so one is added after the others.
+	if extendsOptions {
+		c.extendsOptions = true
+		if !slices.Contains(dependencies, descriptorProtoFile) {
+			dependencies = append(dependencies, descriptorProtoFile)
+		}
+	}
+
+	// protoc leaves the syntax of proto2 files unset.
+	var syntaxName *string
+	if syntax != "proto2" {
+		syntaxName = &syntax
+	}
+	var editionNumber *descriptorpb.Edition
+	if syntax == "editions" {
+		editionNumber = &edition
+	}
 	name := URIToProtoFile(module.URI)
 
 	var package_ *string = nil
@@ -322,31 +450,100 @@ func (c *imageConverter) fromModule(module *proto.Module) (*descriptorpb.FileDes
 		package_ = &module.ProtobufPackage
 	}
 
+	options, err := withOptions[*descriptorpb.FileOptions](c, nil, module.AnnotationApplications)
+	if err != nil {
+		return nil, err
+	}
+
 	return &descriptorpb.FileDescriptorProto{
-		Name:       &name,
-		Package:    package_,
-		Dependency: dependencies,
-		// PublicDependency
-		// WeakDependency
-		MessageType: messageTypes,
-		EnumType:    enumTypes,
-		Service:     services,
-		// Extension
-
-		Options: &descriptorpb.FileOptions{
-			GoPackage: getProtobufAnnotationString(module.AnnotationApplications, "FileOptionsGoPackage"),
-			// TODO 2023.12.30: remaining options
-		},
+		Name:             &name,
+		Package:          package_,
+		Dependency:       dependencies,
+		PublicDependency: publicDependencies,
+		WeakDependency:   weakDependencies,
+		MessageType:      messageTypes,
+		EnumType:         enumTypes,
+		Service:          services,
+		Extension:        extensions,
+
+		Options: options,
 
 		SourceCodeInfo: &descriptorpb.SourceCodeInfo{
 			Location: c.location,
 		},
 
-		Syntax: &syntax,
-		// Edition
+		Syntax:  syntaxName,
+		Edition: editionNumber,
 	}, nil
 }
 
+// fromAnnotation converts an annotation to an extension of each of the
+// protobuf options messages for its scopes, so that protoc plugins can read
+// its applications as custom options. The number of each extension is taken
+// from the UID of the annotation. Annotations of types that an extension
+// can't have are left out.
+func (c *imageConverter) fromAnnotation(annotation *proto.Annotation) ([]*descriptorpb.FieldDescriptorProto, error) {
+	if !c.image.IsExtension(annotation) {
+		return nil, nil
+	}
+	var extensions []*descriptorpb.FieldDescriptorProto
+	for _, extendee := range Extendees(annotation) {
+		label, type_, typeName, err := c.fromTypeSpecifier(annotation.Type, nil)
+		if err != nil {
+			return nil, err
+		}
+		name := ExtensionName(annotation, extendee)
+		number := ExtensionNumber(annotation.Reference)
+		qualifiedExtendee := "." + extendee
+		c.maybeEmitLocation(annotation.DescriptorCommentBlock)
+		extensions = append(extensions, &descriptorpb.FieldDescriptorProto{
+			Name:     &name,
+			Number:   &number,
+			Label:    label,
+			Type:     type_,
+			TypeName: typeName,
+			Extendee: &qualifiedExtendee,
+		})
+		c.p.IncrementIndex()
+	}
+	return extensions, nil
+}
+
+// fromExtension converts an extension of a struct to an extension of the
+// message that the struct is converted to.
+func (c *imageConverter) fromExtension(extension *proto.Extension) (*descriptorpb.FieldDescriptorProto, error) {
+	_, _, extendee, err := c.fromTypeSpecifier(extension.Extendee, nil)
+	if err != nil {
+		return nil, err
+	}
+	field, err := c.convertField(extension.Field, true)
+	if err != nil {
+		return nil, err
+	}
+	field.Extendee = extendee
+	return field, nil
+}
+
+// declarationLocation returns the location of an annotation or an extension.
+func declarationLocation(declaration interface{}) *proto.SourceLocation {
+	switch d := declaration.(type) {
+	case *proto.Annotation:
+		return d.Location
+	case *proto.Extension:
+		return d.Field.Location
+	}
+	return nil
+}
+
+// compareLocations orders source locations by line and then column. A missing
+// location comes first.
+func compareLocations(a *proto.SourceLocation, b *proto.SourceLocation) int {
+	if a.GetLine() != b.GetLine() {
+		return int(a.GetLine() - b.GetLine())
+	}
+	return int(a.GetColumn() - b.GetColumn())
+}
+
 func (c *imageConverter) synthesizeMapEntries(module *proto.Module, struct_ *proto.Struct) ([]*descriptorpb.DescriptorProto, error) {
 	var synthetics []*descriptorpb.DescriptorProto
 	for _, field := range struct_.Fields {
@@ -450,6 +647,15 @@ func (c *imageConverter) fromStruct(module *proto.Module, struct_ *proto.Struct)
 	c.p.PopIndex()
 	c.p.PopFieldNumber()
 
+	c.p.PushFieldNumber( /* Extension */ 6)
+	c.p.PushIndex()
+	extensions, err := mapFrom(c, struct_.Extensions, c.fromExtension)
+	if err != nil {
+		return nil, err
+	}
+	c.p.PopIndex()
+	c.p.PopFieldNumber()
+
 	oneofs, err := mapFrom(c, struct_.Unions, c.fromUnion)
 	if err != nil {
 		return nil, err
@@ -472,9 +678,17 @@ func (c *imageConverter) fromStruct(module *proto.Module, struct_ *proto.Struct)
 		options.MapEntry = new(bool)
 		*(options.MapEntry) = true
 	}
+	options, err = withOptions(c, options, struct_.AnnotationApplications)
+	if err != nil {
+		return nil, err
+	}
 
 	var enumType []*descriptorpb.EnumDescriptorProto
-	for nestedName, promotedName := range GetPromotedSymbolTable(struct_.AnnotationApplications) {
+	// The nested types are emitted in the order they were recorded, rather than
+	// map order, so that the output is the same from one compilation to the next.
+	promotedSymbolTable := GetPromotedSymbolTable(struct_.AnnotationApplications)
+	for _, nestedName := range getPromotedNames(struct_.AnnotationApplications) {
+		promotedName := promotedSymbolTable[nestedName]
 		maybeStruct := c.lookupStruct(struct_.Reference.ModuleUID, promotedName)
 		if maybeStruct == nil {
 			maybeEnum := c.lookupEnum(struct_.Reference.ModuleUID, promotedName)
@@ -485,7 +699,9 @@ func (c *imageConverter) fromStruct(module *proto.Module, struct_ *proto.Struct)
 			if err != nil {
 				return nil, err
 			}
-			*maybeEnumType.Name = nestedName
+			// The name points into the image, which may be converted
+			// again, so it is replaced rather than overwritten.
+			maybeEnumType.Name = &nestedName
 			enumType = append(enumType, maybeEnumType)
 		} else {
 			maybeNestedType, err := c.fromStruct(module, maybeStruct)
@@ -506,27 +722,81 @@ func (c *imageConverter) fromStruct(module *proto.Module, struct_ *proto.Struct)
 	//                   them with a helper that always generates a pointer to
 	//                   a copy.
 	return &descriptorpb.DescriptorProto{
-		Name:       name,
-		Field:      fields,
-		OneofDecl:  oneofs,
-		Options:    options,
-		NestedType: nestedType,
-		EnumType:   enumType,
-		// Extension
-		// ExtensionRange
-		// ReservedRange
-		// ReservedName
+		Name:           name,
+		Field:          fields,
+		OneofDecl:      oneofs,
+		Options:        options,
+		NestedType:     nestedType,
+		EnumType:       enumType,
+		Extension:      extensions,
+		ExtensionRange: fromStructExtensionRanges(struct_.ExtensionRanges),
+		ReservedRange:  fromStructReserved(struct_.Reserved),
+		ReservedName:   struct_.ReservedNames,
 	}, nil
 }
 
+// fromStructReserved converts reserved UIDs to reserved field numbers, which
+// exclude the end of each range. Any part of a range that can't be a field
+// number is left out.
+func fromStructReserved(reserved []*proto.ReservedRange) []*descriptorpb.DescriptorProto_ReservedRange {
+	var result []*descriptorpb.DescriptorProto_ReservedRange
+	for _, r := range reserved {
+		start, end := r.Start, r.End
+		if start < 1 {
+			start = 1
+		}
+		if end > MaxFieldUID {
+			end = MaxFieldUID
+		}
+		if start > end {
+			continue
+		}
+		protoStart := int32(start)
+		protoEnd := int32(end + 1)
+		result = append(result, &descriptorpb.DescriptorProto_ReservedRange{
+			Start: &protoStart,
+			End:   &protoEnd,
+		})
+	}
+	return result
+}
+
+// fromStructExtensionRanges converts extension ranges of UIDs to ranges of
+// extension numbers, which exclude the end of each range.
+func fromStructExtensionRanges(ranges []*proto.ExtensionRange) []*descriptorpb.DescriptorProto_ExtensionRange {
+	var result []*descriptorpb.DescriptorProto_ExtensionRange
+	for _, r := range ranges {
+		protoStart := int32(r.Start)
+		protoEnd := int32(r.End + 1)
+		result = append(result, &descriptorpb.DescriptorProto_ExtensionRange{
+			Start: &protoStart,
+			End:   &protoEnd,
+		})
+	}
+	return result
+}
+
 func (c *imageConverter) fromUnion(union *proto.Union) (*descriptorpb.OneofDescriptorProto, error) {
+	options, err := withOptions[*descriptorpb.OneofOptions](c, nil, union.AnnotationApplications)
+	if err != nil {
+		return nil, err
+	}
 	return &descriptorpb.OneofDescriptorProto{
-		Name: &union.Name,
-		// Options
+		Name:    &union.Name,
+		Options: options,
 	}, nil
 }
 
 func (c *imageConverter) fromField(field *proto.Field) (*descriptorpb.FieldDescriptorProto, error) {
+	return c.convertField(field, false)
+}
+
+// convertField converts a field of a struct, or the field of an extension, to
+// the form that the syntax of the module calls for. Proto3 marks the fields
+// that have presence as optional, proto2 and editions keep default values,
+// and editions select presence and encodings with features instead of labels
+// and types.
+func (c *imageConverter) convertField(field *proto.Field, isExtension bool) (*descriptorpb.FieldDescriptorProto, error) {
 	number := int32(field.Reference.AttributeUID)
 	label, type_, typeName, err := c.fromTypeSpecifier(field.Type, &field.Name)
 	if err != nil {
@@ -539,10 +809,54 @@ func (c *imageConverter) fromField(field *proto.Field) (*descriptorpb.FieldDescr
 		*oneofIndex = (int32)(*field.UnionIndex)
 	}
 
+	fieldType, err := c.image.FieldType(field)
+	if err != nil {
+		return nil, err
+	}
+	if fieldType != nil {
+		type_ = fieldType
+	}
+	// Files that use editions mark required fields with the field_presence
+	// feature instead, which the field's options keep.
+	if required := getProtobufAnnotationBool(field.AnnotationApplications, "Required"); required != nil && *required && c.syntax != "editions" {
+		label = descriptorpb.FieldDescriptorProto_LABEL_REQUIRED.Enum()
+	}
+	if err := c.image.CheckGroup(field); err != nil {
+		return nil, err
+	}
+	if group := getProtobufAnnotationBool(field.AnnotationApplications, "Group"); group != nil && *group {
+		// Files that use editions encode groups with the message_encoding
+		// feature instead.
+		if c.syntax != "editions" {
+			type_ = descriptorpb.FieldDescriptorProto_TYPE_GROUP.Enum()
+		}
+	}
+
 	proto3Optional := getProtobufAnnotationBool(field.AnnotationApplications, "Proto3Optional")
 	if proto3Optional != nil && *proto3Optional == false {
 		proto3Optional = nil
 	}
+	if c.syntax == "proto3" && proto3Optional == nil && BuiltinTypeName(field.Type) == "Presence" && field.UnionIndex == nil && !isExtension {
+		proto3Optional = new(bool)
+		*proto3Optional = true
+	}
+
+	var defaultValue *string
+	if field.DefaultValue != nil && c.syntax != "proto3" {
+		value, err := c.fromDefaultValue(field.DefaultValue)
+		if err != nil {
+			return nil, err
+		}
+		defaultValue = &value
+	}
+
+	options, err := withOptions[*descriptorpb.FieldOptions](c, nil, field.AnnotationApplications)
+	if err != nil {
+		return nil, err
+	}
+	if c.syntax == "editions" {
+		options = c.withFieldFeatures(options, field, isExtension, *label, *type_)
+	}
 
 	c.maybeEmitLocation(field.CommentBlock)
 	return &descriptorpb.FieldDescriptorProto{
@@ -552,10 +866,10 @@ func (c *imageConverter) fromField(field *proto.Field) (*descriptorpb.FieldDescr
 		Type:     type_,
 		TypeName: typeName,
 		// Extendee
-		// DefaultValue
-		OneofIndex: oneofIndex,
-		JsonName:   getProtobufAnnotationString(field.AnnotationApplications, "JsonName"),
-		// Options
+		DefaultValue:   defaultValue,
+		OneofIndex:     oneofIndex,
+		JsonName:       getProtobufAnnotationString(field.AnnotationApplications, "JsonName"),
+		Options:        options,
 		Proto3Optional: proto3Optional,
 	}, nil
 }
@@ -582,7 +896,8 @@ func (c *imageConverter) fromResolvedReference(resolvedReference *proto.Resolved
 			return nil, nil, nil, fmt.Errorf("unknown built-in type UID: %d", resolvedReference.Reference.TypeUID)
 		}
 
-		// TODO 2023.11.09: respect $(Protobuf.FieldType())
+		// Integers use their default encodings here. fromField applies any
+		// other encoding that $(Protobuf.FieldType()) selects.
 
 		switch builtinTypeName.Name {
 		case "Bool":
@@ -706,14 +1021,28 @@ func (c *imageConverter) fromResolvedReference(resolvedReference *proto.Resolved
 }
 
 func (c *imageConverter) fromEnum(enum *proto.Enum) (*descriptorpb.EnumDescriptorProto, error) {
+	options, err := withOptions[*descriptorpb.EnumOptions](c, nil, enum.AnnotationApplications)
+	if err != nil {
+		return nil, err
+	}
+	if c.syntax == "editions" {
+		options = c.withEnumFeatures(options, enum)
+	}
 	result := &descriptorpb.EnumDescriptorProto{
-		Name: &enum.Name,
-		// Options
-		// ReservedRange
-		// ReservedName
+		Name:    &enum.Name,
+		Options: options,
+	}
+	for _, r := range enum.Reserved {
+		start := int32(r.Start)
+		end := int32(r.End)
+		result.ReservedRange = append(result.ReservedRange, &descriptorpb.EnumDescriptorProto_EnumReservedRange{
+			Start: &start,
+			End:   &end,
+		})
 	}
 	fromProto := getProtobufAnnotationBool(enum.AnnotationApplications, "EnumFromProto")
 	if fromProto != nil && *fromProto {
+		result.ReservedName = enum.ReservedNames
 		values, err := mapFrom(c, enum.Enumerants, c.fromEnumerant)
 		if err != nil {
 			return nil, err
@@ -727,6 +1056,11 @@ func (c *imageConverter) fromEnum(enum *proto.Enum) (*descriptorpb.EnumDescripto
 			return nil, err
 		}
 		result.Value = values
+		// Microglot enumerants are prefixed with the enum name in protobuf,
+		// so their reserved names are too.
+		for _, name := range enum.ReservedNames {
+			result.ReservedName = append(result.ReservedName, enum.Name+"_"+name)
+		}
 	}
 
 	return result, nil
@@ -734,10 +1068,14 @@ func (c *imageConverter) fromEnum(enum *proto.Enum) (*descriptorpb.EnumDescripto
 
 func (c *imageConverter) fromEnumerant(enumerant *proto.Enumerant) (*descriptorpb.EnumValueDescriptorProto, error) {
 	number := (int32)(enumerant.Reference.AttributeUID)
+	options, err := withOptions[*descriptorpb.EnumValueOptions](c, nil, enumerant.AnnotationApplications)
+	if err != nil {
+		return nil, err
+	}
 	return &descriptorpb.EnumValueDescriptorProto{
-		Name:   &enumerant.Name,
-		Number: &number,
-		// Options
+		Name:    &enumerant.Name,
+		Number:  &number,
+		Options: options,
 	}, nil
 }
 
@@ -747,16 +1085,19 @@ func (c *imageConverter) fromMicroglotEnumerant(enum *proto.Enum, enumerant *pro
 	microglotName := enumerant.Name
 	optMicroglotName := "MicroglotName"
 	f := false
+	options, err := withOptions(c, &descriptorpb.EnumValueOptions{UninterpretedOption: []*descriptorpb.UninterpretedOption{
+		&descriptorpb.UninterpretedOption{
+			Name:        []*descriptorpb.UninterpretedOption_NamePart{&descriptorpb.UninterpretedOption_NamePart{NamePart: &optMicroglotName, IsExtension: &f}},
+			StringValue: []byte(microglotName),
+		},
+	}}, enumerant.AnnotationApplications)
+	if err != nil {
+		return nil, err
+	}
 	return &descriptorpb.EnumValueDescriptorProto{
-		Name:   &name,
-		Number: &number,
-		// Options
-		Options: &descriptorpb.EnumValueOptions{UninterpretedOption: []*descriptorpb.UninterpretedOption{
-			&descriptorpb.UninterpretedOption{
-				Name:        []*descriptorpb.UninterpretedOption_NamePart{&descriptorpb.UninterpretedOption_NamePart{NamePart: &optMicroglotName, IsExtension: &f}},
-				StringValue: []byte(microglotName),
-			},
-		}},
+		Name:    &name,
+		Number:  &number,
+		Options: options,
 	}, nil
 }
 
@@ -766,10 +1107,15 @@ func (c *imageConverter) fromAPI(api *proto.API) (*descriptorpb.ServiceDescripto
 		return nil, err
 	}
 
+	options, err := withOptions[*descriptorpb.ServiceOptions](c, nil, api.AnnotationApplications)
+	if err != nil {
+		return nil, err
+	}
+
 	return &descriptorpb.ServiceDescriptorProto{
-		Name:   &api.Name.Name,
-		Method: methods,
-		// Options
+		Name:    &api.Name.Name,
+		Method:  methods,
+		Options: options,
 	}, nil
 }
 
@@ -783,12 +1129,246 @@ func (c *imageConverter) fromAPIMethod(apiMethod *proto.APIMethod) (*descriptorp
 		return nil, err
 	}
 
-	return &descriptorpb.MethodDescriptorProto{
+	options, err := withOptions[*descriptorpb.MethodOptions](c, nil, apiMethod.AnnotationApplications)
+	if err != nil {
+		return nil, err
+	}
+
+	method := &descriptorpb.MethodDescriptorProto{
 		Name:       &apiMethod.Name,
 		InputType:  inputTypeName,
 		OutputType: outputTypeName,
-		// Options:
-		// ClientStreaming:
-		// ServerStreaming:
-	}, nil
+		Options:    options,
+	}
+	if apiMethod.InputStream {
+		method.ClientStreaming = &apiMethod.InputStream
+	}
+	if apiMethod.OutputStream {
+		method.ServerStreaming = &apiMethod.OutputStream
+	}
+	return method, nil
+}
+
+// withOptions adds the options among the given annotation applications to an
+// options message, which is allocated if it's nil and there are any. The
+// standard options are set from the Protobuf annotations that represent them.
+// The custom options are encoded as unknown fields, which plugins that know
+// the extensions parse when they decode their request.
+func withOptions[T interface {
+	*O
+	protoreflect.ProtoMessage
+}, O any](c *imageConverter, options T, as []*proto.AnnotationApplication) (T, error) {
+	result := options
+	if result == nil {
+		result = T(new(O))
+	}
+	message := result.ProtoReflect()
+	err := ToOptions(message, as)
+	if err != nil {
+		return nil, err
+	}
+	b, err := c.fromCustomOptions(as)
+	if err != nil {
+		return nil, err
+	}
+	message.SetUnknown(append(message.GetUnknown(), b...))
+	if options == nil {
+		isEmpty := len(message.GetUnknown()) < 1
+		message.Range(func(protoreflect.FieldDescriptor, protoreflect.Value) bool {
+			isEmpty = false
+			return false
+		})
+		if isEmpty {
+			return nil, nil
+		}
+	}
+	return result, nil
+}
+
+// fromCustomOptions encodes the applications of annotations that are
+// converted to extensions of the protobuf options messages, in the wire
+// format of those extensions.
+func (c *imageConverter) fromCustomOptions(as []*proto.AnnotationApplication) ([]byte, error) {
+	var b []byte
+	for _, annotationApplication := range as {
+		resolved, ok := annotationApplication.Annotation.Reference.(*proto.TypeSpecifier_Resolved)
+		if !ok {
+			continue
+		}
+		annotation := c.lookupExtension(resolved.Resolved.Reference)
+		if annotation == nil {
+			continue
+		}
+		var err error
+		b, err = c.appendOptionValue(b, protowire.Number(ExtensionNumber(annotation.Reference)), annotation.Type, nil, annotationApplication.Value)
+		if err != nil {
+			return nil, fmt.Errorf("option (%s): %w", annotation.Name, err)
+		}
+	}
+	return b, nil
+}
+
+// lookupExtension returns the annotation with the given reference if it is
+// converted to extensions.
+func (c *imageConverter) lookupExtension(reference *proto.TypeReference) *proto.Annotation {
+	kind, declaration := c.image.Lookup(reference)
+	if kind != TypeKindAnnotation || !c.image.IsExtension(declaration.(*proto.Annotation)) {
+		return nil
+	}
+	return declaration.(*proto.Annotation)
+}
+
+// appendOptionValue appends a value of the given type as the field with the
+// given number. Repeated values are appended as one field each. Integers are
+// encoded as the fieldType, if it isn't nil, which is selected for the fields of
+// structs by $(Protobuf.FieldType()).
+func (c *imageConverter) appendOptionValue(b []byte, number protowire.Number, typeSpecifier *proto.TypeSpecifier, fieldType *descriptorpb.FieldDescriptorProto_Type, value *proto.Value) ([]byte, error) {
+	resolved, ok := typeSpecifier.Reference.(*proto.TypeSpecifier_Resolved)
+	if !ok {
+		return nil, errors.New("unexpected forward reference while converting descriptor to protobuf!")
+	}
+	kind, declaration := c.image.Lookup(resolved.Resolved.Reference)
+	switch kind {
+	case TypeKindVirtual:
+		switch declaration.(*proto.Struct).Name.Name {
+		case "List":
+			list, ok := value.Kind.(*proto.Value_List)
+			if !ok {
+				return nil, fmt.Errorf("expecting a list, found %T", value.Kind)
+			}
+			for _, element := range list.List.Elements {
+				var err error
+				b, err = c.appendOptionValue(b, number, resolved.Resolved.Parameters[0], fieldType, element)
+				if err != nil {
+					return nil, err
+				}
+			}
+			return b, nil
+		case "Presence":
+			return c.appendOptionValue(b, number, resolved.Resolved.Parameters[0], fieldType, value)
+		case "Map":
+			// Each entry is encoded as the message that protobuf synthesizes
+			// for the entries of map fields, whose key and value are its
+			// fields 1 and 2.
+			map_, ok := value.Kind.(*proto.Value_Map)
+			if !ok {
+				return nil, fmt.Errorf("expecting a map, found %T", value.Kind)
+			}
+			for _, entry := range map_.Map.Entries {
+				message, err := c.appendOptionValue(nil, 1, resolved.Resolved.Parameters[0], nil, entry.Key)
+				if err != nil {
+					return nil, err
+				}
+				message, err = c.appendOptionValue(message, 2, resolved.Resolved.Parameters[1], nil, entry.Value)
+				if err != nil {
+					return nil, err
+				}
+				b = protowire.AppendBytes(protowire.AppendTag(b, number, protowire.BytesType), message)
+			}
+			return b, nil
+		}
+	case TypeKindPrimitive, TypeKindData:
+		// TODO 2026.10.16: annotations can't select the encoding of their own
+		// values, unlike the fields of structs, because annotations can't be
+		// annotated.
+		name := declaration.(*proto.Struct).Name.Name
+		switch kind := value.Kind.(type) {
+		case *proto.Value_Bool:
+			return protowire.AppendVarint(protowire.AppendTag(b, number, protowire.VarintType), protowire.EncodeBool(kind.Bool.Value)), nil
+		case *proto.Value_Text:
+			return protowire.AppendString(protowire.AppendTag(b, number, protowire.BytesType), kind.Text.Value), nil
+		case *proto.Value_Data:
+			return protowire.AppendBytes(protowire.AppendTag(b, number, protowire.BytesType), kind.Data.Value), nil
+		case *proto.Value_Float32, *proto.Value_Float64:
+			f := value.GetFloat64().GetValue()
+			if float32, ok := kind.(*proto.Value_Float32); ok {
+				f = float64(float32.Float32.Value)
+			}
+			if name == "Float32" {
+				return protowire.AppendFixed32(protowire.AppendTag(b, number, protowire.Fixed32Type), math.Float32bits(float32(f))), nil
+			}
+			return protowire.AppendFixed64(protowire.AppendTag(b, number, protowire.Fixed64Type), math.Float64bits(f)), nil
+		}
+		if v, ok := integerBits(value); ok {
+			if fieldType != nil {
+				switch *fieldType {
+				case descriptorpb.FieldDescriptorProto_TYPE_SINT32, descriptorpb.FieldDescriptorProto_TYPE_SINT64:
+					return protowire.AppendVarint(protowire.AppendTag(b, number, protowire.VarintType), protowire.EncodeZigZag(int64(v))), nil
+				case descriptorpb.FieldDescriptorProto_TYPE_FIXED32, descriptorpb.FieldDescriptorProto_TYPE_SFIXED32:
+					return protowire.AppendFixed32(protowire.AppendTag(b, number, protowire.Fixed32Type), uint32(v)), nil
+				case descriptorpb.FieldDescriptorProto_TYPE_FIXED64, descriptorpb.FieldDescriptorProto_TYPE_SFIXED64:
+					return protowire.AppendFixed64(protowire.AppendTag(b, number, protowire.Fixed64Type), v), nil
+				}
+			}
+			return protowire.AppendVarint(protowire.AppendTag(b, number, protowire.VarintType), v), nil
+		}
+		return nil, fmt.Errorf("%T can't be encoded as %s", value.Kind, name)
+	case TypeKindEnum:
+		var enumerant *proto.AttributeReference
+		switch kind := value.Kind.(type) {
+		case *proto.Value_Enumerant:
+			enumerant = kind.Enumerant
+		case *proto.Value_Identifier:
+			enumerant = kind.Identifier.GetAttribute()
+		}
+		if enumerant == nil {
+			return nil, fmt.Errorf("expecting an enum value, found %T", value.Kind)
+		}
+		// Enumerant UIDs hold negative values sign-extended, which is how
+		// they are encoded too.
+		return protowire.AppendVarint(protowire.AppendTag(b, number, protowire.VarintType), enumerant.AttributeUID), nil
+	case TypeKindStruct:
+		struct_ := declaration.(*proto.Struct)
+		valueStruct, ok := value.Kind.(*proto.Value_Struct)
+		if !ok {
+			return nil, fmt.Errorf("expecting a %s, found %T", struct_.Name.Name, value.Kind)
+		}
+		var message []byte
+		for _, valueStructField := range valueStruct.Struct.Fields {
+			var field *proto.Field
+			for _, candidate := range struct_.Fields {
+				if candidate.Name == valueStructField.Name {
+					field = candidate
+					break
+				}
+			}
+			if field == nil {
+				return nil, fmt.Errorf("%s has no field named %s", struct_.Name.Name, valueStructField.Name)
+			}
+			fieldType, err := c.image.FieldType(field)
+			if err != nil {
+				return nil, err
+			}
+			message, err = c.appendOptionValue(message, protowire.Number(field.Reference.AttributeUID), field.Type, fieldType, valueStructField.Value)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return protowire.AppendBytes(protowire.AppendTag(b, number, protowire.BytesType), message), nil
+	}
+	return nil, fmt.Errorf("values of type %d can't be encoded as options", kind)
+}
+
+// integerBits returns an integer value as a varint, in which negative values
+// are sign-extended.
+func integerBits(value *proto.Value) (uint64, bool) {
+	switch kind := value.Kind.(type) {
+	case *proto.Value_Int8:
+		return uint64(int64(kind.Int8.Value)), true
+	case *proto.Value_Int16:
+		return uint64(int64(kind.Int16.Value)), true
+	case *proto.Value_Int32:
+		return uint64(int64(kind.Int32.Value)), true
+	case *proto.Value_Int64:
+		return uint64(kind.Int64.Value), true
+	case *proto.Value_UInt8:
+		return uint64(kind.UInt8.Value), true
+	case *proto.Value_UInt16:
+		return uint64(kind.UInt16.Value), true
+	case *proto.Value_UInt32:
+		return uint64(kind.UInt32.Value), true
+	case *proto.Value_UInt64:
+		return kind.UInt64.Value, true
+	}
+	return 0, false
 }
@@ -0,0 +1,60 @@
+// © 2023 Microglot LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package idl
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/prototext"
+	pb "google.golang.org/protobuf/proto"
+
+	"gopkg.microglot.org/mglotc/internal/proto"
+)
+
+// Marshal serializes the image in the format identified by kind. Unlike
+// ToFileDescriptorSet, this is lossless and retains the content that has no
+// protobuf equivalent, such as SDKs, constants, and annotations.
+//
+// FileKindMicroglotDescBinary uses the protobuf wire format,
+// FileKindMicroglotDescJSON uses the protobuf JSON mapping, and
+// FileKindMicroglotDescProto uses the protobuf text format.
+func (i *Image) Marshal(kind FileKind) ([]byte, error) {
+	image := &proto.Image{
+		Modules: i.Modules,
+	}
+	switch kind {
+	case FileKindMicroglotDescBinary:
+		return pb.MarshalOptions{Deterministic: true}.Marshal(image)
+	case FileKindMicroglotDescJSON:
+		return protojson.MarshalOptions{Multiline: true}.Marshal(image)
+	case FileKindMicroglotDescProto:
+		return prototext.MarshalOptions{Multiline: true}.Marshal(image)
+	default:
+		return nil, fmt.Errorf("unsupported image format %s", kind)
+	}
+}
+
+// UnmarshalImage is the inverse of Image.Marshal.
+func UnmarshalImage(kind FileKind, b []byte) (*Image, error) {
+	image := &proto.Image{}
+	var err error
+	switch kind {
+	case FileKindMicroglotDescBinary:
+		err = pb.Unmarshal(b, image)
+	case FileKindMicroglotDescJSON:
+		err = protojson.Unmarshal(b, image)
+	case FileKindMicroglotDescProto:
+		err = prototext.Unmarshal(b, image)
+	default:
+		err = fmt.Errorf("unsupported image format %s", kind)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &Image{
+		Modules: image.Modules,
+	}, nil
+}
@@ -0,0 +1,347 @@
+// © 2023 Microglot LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package idl
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"gopkg.microglot.org/mglotc/internal/proto"
+)
+
+// optionAnnotation is a Protobuf annotation that represents a standard option,
+// which is a field of one of the protobuf options messages. It is named after
+// the message and the field, such as FileOptionsJavaPackage. The features of
+// protobuf editions are fields of the FeatureSet that the features field of
+// each options message holds, so their annotations also name the parent
+// field, such as FieldOptionsFeaturesFieldPresence.
+type optionAnnotation struct {
+	name   string
+	uid    uint64
+	scope  proto.AnnotationScope
+	parent protoreflect.FieldDescriptor
+	field  protoreflect.FieldDescriptor
+}
+
+// options returns the options message that holds the option.
+func (annotation *optionAnnotation) options() protoreflect.MessageDescriptor {
+	if annotation.parent != nil {
+		return annotation.parent.ContainingMessage()
+	}
+	return annotation.field.ContainingMessage()
+}
+
+// optionAnnotations lists the annotations for the standard options in the
+// order of optionsScopes and then of the fields of each options message.
+var optionAnnotations = newOptionAnnotations()
+
+// newOptionAnnotations derives an annotation for each field of the options
+// messages that holds a boolean, a string, or enum values, which are given by
+// name. The fields that hold messages and the map_entry option, which is
+// represented by Struct.IsSynthetic, are left out, except for the features,
+// which get an annotation for each feature that applies to the scope. The UID
+// of each annotation is the one in PROTOBUF_TYPE_UIDS, if it has one, or is
+// derived from the position of the options message and the number of the
+// field, offset by featuresUIDOffset for features.
+func newOptionAnnotations() []optionAnnotation {
+	var annotations []optionAnnotation
+	for index, scope := range optionsScopes {
+		fullName := protoreflect.FullName(optionsMessages[scope])
+		message := descriptorpb.File_google_protobuf_descriptor_proto.Messages().ByName(fullName.Name())
+		fields := message.Fields()
+		for i := 0; i < fields.Len(); i = i + 1 {
+			field := fields.Get(i)
+			if field.Message() != nil && field.Message().FullName() == featureSetName {
+				annotations = append(annotations, newFeatureAnnotations(index, scope, field)...)
+				continue
+			}
+			if field.Message() != nil || (field.Cardinality() == protoreflect.Repeated && field.Kind() != protoreflect.EnumKind) {
+				continue
+			}
+			if fullName == "google.protobuf.MessageOptions" && field.Name() == "map_entry" {
+				continue
+			}
+			switch field.Kind() {
+			case protoreflect.BoolKind, protoreflect.StringKind, protoreflect.EnumKind:
+			default:
+				continue
+			}
+			name := string(message.Name()) + camelCase(field.Name())
+			uid, ok := PROTOBUF_TYPE_UIDS[name]
+			if !ok {
+				uid = uint64(index+1)*1000 + uint64(field.Number())
+			}
+			annotations = append(annotations, optionAnnotation{name, uid, scope, nil, field})
+		}
+	}
+	return annotations
+}
+
+const featureSetName protoreflect.FullName = "google.protobuf.FeatureSet"
+
+// featuresUIDOffset keeps the UIDs of the features apart from those of the
+// fields of the options messages, which are numbered well below it.
+const featuresUIDOffset = 900
+
+// featureTargets maps each scope to the target that features must name in
+// order to be set in its options message.
+var featureTargets = map[proto.AnnotationScope]descriptorpb.FieldOptions_OptionTargetType{
+	proto.AnnotationScope_AnnotationScopeModule:    descriptorpb.FieldOptions_TARGET_TYPE_FILE,
+	proto.AnnotationScope_AnnotationScopeStruct:    descriptorpb.FieldOptions_TARGET_TYPE_MESSAGE,
+	proto.AnnotationScope_AnnotationScopeField:     descriptorpb.FieldOptions_TARGET_TYPE_FIELD,
+	proto.AnnotationScope_AnnotationScopeUnion:     descriptorpb.FieldOptions_TARGET_TYPE_ONEOF,
+	proto.AnnotationScope_AnnotationScopeEnum:      descriptorpb.FieldOptions_TARGET_TYPE_ENUM,
+	proto.AnnotationScope_AnnotationScopeEnumerant: descriptorpb.FieldOptions_TARGET_TYPE_ENUM_ENTRY,
+	proto.AnnotationScope_AnnotationScopeAPI:       descriptorpb.FieldOptions_TARGET_TYPE_SERVICE,
+	proto.AnnotationScope_AnnotationScopeAPIMethod: descriptorpb.FieldOptions_TARGET_TYPE_METHOD,
+}
+
+// newFeatureAnnotations derives an annotation for each feature that the
+// features field of an options message can set, which are the fields of the
+// FeatureSet that hold enum values and name the target of the scope.
+func newFeatureAnnotations(index int, scope proto.AnnotationScope, parent protoreflect.FieldDescriptor) []optionAnnotation {
+	var annotations []optionAnnotation
+	fields := parent.Message().Fields()
+	for i := 0; i < fields.Len(); i = i + 1 {
+		field := fields.Get(i)
+		if field.Kind() != protoreflect.EnumKind || field.Cardinality() == protoreflect.Repeated {
+			continue
+		}
+		targets := field.Options().(*descriptorpb.FieldOptions).GetTargets()
+		if !slices.Contains(targets, featureTargets[scope]) {
+			continue
+		}
+		name := string(parent.ContainingMessage().Name()) + camelCase(parent.Name()) + camelCase(field.Name())
+		uid := uint64(index+1)*1000 + featuresUIDOffset + uint64(field.Number())
+		annotations = append(annotations, optionAnnotation{name, uid, scope, parent, field})
+	}
+	return annotations
+}
+
+// camelCase converts the snake case name of a protobuf field to camel case,
+// starting with an upper case letter.
+func camelCase(name protoreflect.Name) string {
+	var b strings.Builder
+	for _, part := range strings.Split(string(name), "_") {
+		if part != "" {
+			b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+		}
+	}
+	return b.String()
+}
+
+// optionAnnotationsIDL declares the annotations for the standard options in
+// the built-in Protobuf module.
+func optionAnnotationsIDL() string {
+	var b strings.Builder
+	for _, annotation := range optionAnnotations {
+		type_ := ":Text"
+		switch {
+		case annotation.field.Cardinality() == protoreflect.Repeated:
+			type_ = ":List<:Text>"
+		case annotation.field.Kind() == protoreflect.BoolKind:
+			type_ = ":Bool"
+		}
+		fmt.Fprintf(&b, "annotation %s(%s) %s @%d\n", annotation.name, optionsScopeNames[annotation.scope], type_, annotation.uid)
+	}
+	return b.String()
+}
+
+var optionsScopeNames = map[proto.AnnotationScope]string{
+	proto.AnnotationScope_AnnotationScopeModule:    "module",
+	proto.AnnotationScope_AnnotationScopeStruct:    "struct",
+	proto.AnnotationScope_AnnotationScopeField:     "field",
+	proto.AnnotationScope_AnnotationScopeUnion:     "union",
+	proto.AnnotationScope_AnnotationScopeEnum:      "enum",
+	proto.AnnotationScope_AnnotationScopeEnumerant: "enumerant",
+	proto.AnnotationScope_AnnotationScopeAPI:       "api",
+	proto.AnnotationScope_AnnotationScopeAPIMethod: "apimethod",
+}
+
+// lookupOptionAnnotation returns the annotation for a standard option that an
+// annotation application applies, if it applies one.
+func lookupOptionAnnotation(annotationApplication *proto.AnnotationApplication) *optionAnnotation {
+	resolved, ok := annotationApplication.Annotation.Reference.(*proto.TypeSpecifier_Resolved)
+	if !ok || resolved.Resolved.Reference.ModuleUID != ProtobufModuleUID {
+		return nil
+	}
+	for index := range optionAnnotations {
+		if optionAnnotations[index].uid == resolved.Resolved.Reference.TypeUID {
+			return &optionAnnotations[index]
+		}
+	}
+	return nil
+}
+
+// FromOptions converts the standard options that are set in an options message
+// to applications of the Protobuf annotations that represent them.
+func FromOptions(options protoreflect.ProtoMessage) []*proto.AnnotationApplication {
+	message := options.ProtoReflect()
+	if !message.IsValid() {
+		return nil
+	}
+	var annotationApplications []*proto.AnnotationApplication
+	for _, annotation := range optionAnnotations {
+		if annotation.options() != message.Descriptor() {
+			continue
+		}
+		holder := message
+		if annotation.parent != nil {
+			if !message.Has(annotation.parent) {
+				continue
+			}
+			holder = message.Get(annotation.parent).Message()
+		}
+		if !holder.Has(annotation.field) {
+			continue
+		}
+		var value *proto.Value
+		switch {
+		case annotation.field.Cardinality() == protoreflect.Repeated:
+			list := holder.Get(annotation.field).List()
+			var elements []*proto.Value
+			for i := 0; i < list.Len(); i = i + 1 {
+				elements = append(elements, textValue(enumValueName(annotation.field, list.Get(i).Enum())))
+			}
+			value = &proto.Value{Kind: &proto.Value_List{List: &proto.ValueList{Elements: elements}}}
+		case annotation.field.Kind() == protoreflect.BoolKind:
+			value = &proto.Value{Kind: &proto.Value_Bool{Bool: &proto.ValueBool{Value: holder.Get(annotation.field).Bool()}}}
+		case annotation.field.Kind() == protoreflect.EnumKind:
+			value = textValue(enumValueName(annotation.field, holder.Get(annotation.field).Enum()))
+		default:
+			value = textValue(holder.Get(annotation.field).String())
+		}
+		annotationApplications = append(annotationApplications, &proto.AnnotationApplication{
+			Annotation: &proto.TypeSpecifier{
+				Reference: &proto.TypeSpecifier_Resolved{
+					Resolved: &proto.ResolvedReference{
+						Reference: &proto.TypeReference{
+							ModuleUID: ProtobufModuleUID,
+							TypeUID:   annotation.uid,
+						},
+					},
+				},
+			},
+			Value: value,
+		})
+	}
+	return annotationApplications
+}
+
+func textValue(value string) *proto.Value {
+	return &proto.Value{Kind: &proto.Value_Text{Text: &proto.ValueText{Value: value}}}
+}
+
+// enumValueName returns the name of an enum value, or its number if the enum
+// has no value with that number.
+func enumValueName(field protoreflect.FieldDescriptor, number protoreflect.EnumNumber) string {
+	value := field.Enum().Values().ByNumber(number)
+	if value == nil {
+		return fmt.Sprint(number)
+	}
+	return string(value.Name())
+}
+
+// ToOptions sets the standard options of an options message that are applied
+// by the Protobuf annotations that represent them. Options of other messages
+// are left out.
+func ToOptions(options protoreflect.Message, annotationApplications []*proto.AnnotationApplication) error {
+	for _, annotationApplication := range annotationApplications {
+		annotation := lookupOptionAnnotation(annotationApplication)
+		if annotation == nil || annotation.options() != options.Descriptor() {
+			continue
+		}
+		message := options
+		if annotation.parent != nil {
+			message = options.Mutable(annotation.parent).Message()
+		}
+		value, err := annotation.value(message, annotationApplication.Value)
+		if err != nil {
+			return err
+		}
+		message.Set(annotation.field, value)
+	}
+	return nil
+}
+
+// CheckOption reports an application of an annotation that represents a
+// standard option whose value names a value that the enum of the option
+// doesn't have. Values of the wrong type are reported by the checker.
+func CheckOption(annotationApplication *proto.AnnotationApplication) error {
+	annotation := lookupOptionAnnotation(annotationApplication)
+	if annotation == nil || annotation.field.Kind() != protoreflect.EnumKind {
+		return nil
+	}
+	values := []*proto.Value{annotationApplication.Value}
+	if list, ok := annotationApplication.Value.Kind.(*proto.Value_List); ok {
+		values = list.List.Elements
+	}
+	for _, value := range values {
+		if _, ok := value.Kind.(*proto.Value_Text); !ok {
+			continue
+		}
+		if _, err := annotation.enumNumber(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// value converts the value of an application of the annotation to a value of
+// the option in the given message, which is the FeatureSet for features.
+func (annotation *optionAnnotation) value(options protoreflect.Message, value *proto.Value) (protoreflect.Value, error) {
+	switch {
+	case annotation.field.Cardinality() == protoreflect.Repeated:
+		elements, ok := value.Kind.(*proto.Value_List)
+		if !ok {
+			return protoreflect.Value{}, fmt.Errorf("%s: expecting a list, found %T", annotation.name, value.Kind)
+		}
+		list := options.NewField(annotation.field).List()
+		for _, element := range elements.List.Elements {
+			number, err := annotation.enumNumber(element)
+			if err != nil {
+				return protoreflect.Value{}, err
+			}
+			list.Append(protoreflect.ValueOfEnum(number))
+		}
+		return protoreflect.ValueOfList(list), nil
+	case annotation.field.Kind() == protoreflect.BoolKind:
+		b, ok := value.Kind.(*proto.Value_Bool)
+		if !ok {
+			return protoreflect.Value{}, fmt.Errorf("%s: expecting Bool, found %T", annotation.name, value.Kind)
+		}
+		return protoreflect.ValueOfBool(b.Bool.Value), nil
+	case annotation.field.Kind() == protoreflect.EnumKind:
+		number, err := annotation.enumNumber(value)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		return protoreflect.ValueOfEnum(number), nil
+	}
+	text, ok := value.Kind.(*proto.Value_Text)
+	if !ok {
+		return protoreflect.Value{}, fmt.Errorf("%s: expecting Text, found %T", annotation.name, value.Kind)
+	}
+	return protoreflect.ValueOfString(text.Text.Value), nil
+}
+
+// enumNumber converts the name of a value of the enum that the option holds to
+// its number.
+func (annotation *optionAnnotation) enumNumber(value *proto.Value) (protoreflect.EnumNumber, error) {
+	text, ok := value.Kind.(*proto.Value_Text)
+	if !ok {
+		return 0, fmt.Errorf("%s: expecting Text, found %T", annotation.name, value.Kind)
+	}
+	enum := annotation.field.Enum()
+	enumValue := enum.Values().ByName(protoreflect.Name(text.Text.Value))
+	if enumValue == nil {
+		return 0, fmt.Errorf("%s: %s isn't a value of %s", annotation.name, text.Text.Value, enum.FullName())
+	}
+	return enumValue.Number(), nil
+}
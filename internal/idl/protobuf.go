@@ -6,8 +6,330 @@ package idl
 
 import (
 	"fmt"
+	"slices"
+	"strings"
+
+	pb "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"gopkg.microglot.org/mglotc/internal/proto"
 )
 
+const (
+	// MaxFieldUID is the largest UID that a field may have, which is the
+	// largest field number that protobuf allows.
+	MaxFieldUID uint64 = 1<<29 - 1
+	// FirstImplementationFieldUID and LastImplementationFieldUID bound the
+	// field numbers that protobuf reserves for its own implementation.
+	FirstImplementationFieldUID uint64 = 19000
+	LastImplementationFieldUID  uint64 = 19999
+	// FirstExtensionNumber is the smallest field number that the protobuf
+	// options messages accept for their extensions.
+	FirstExtensionNumber uint64 = 1000
+	// ProtobufModuleUID is the UID of the built-in module that declares the
+	// Protobuf annotations.
+	ProtobufModuleUID uint64 = 2
+)
+
+// optionsScopes lists the annotation scopes that have a protobuf options
+// message, in the order in which their extensions are emitted.
+var optionsScopes = []proto.AnnotationScope{
+	proto.AnnotationScope_AnnotationScopeModule,
+	proto.AnnotationScope_AnnotationScopeStruct,
+	proto.AnnotationScope_AnnotationScopeField,
+	proto.AnnotationScope_AnnotationScopeUnion,
+	proto.AnnotationScope_AnnotationScopeEnum,
+	proto.AnnotationScope_AnnotationScopeEnumerant,
+	proto.AnnotationScope_AnnotationScopeAPI,
+	proto.AnnotationScope_AnnotationScopeAPIMethod,
+}
+
+var optionsMessages = map[proto.AnnotationScope]string{
+	proto.AnnotationScope_AnnotationScopeModule:    "google.protobuf.FileOptions",
+	proto.AnnotationScope_AnnotationScopeStruct:    "google.protobuf.MessageOptions",
+	proto.AnnotationScope_AnnotationScopeField:     "google.protobuf.FieldOptions",
+	proto.AnnotationScope_AnnotationScopeUnion:     "google.protobuf.OneofOptions",
+	proto.AnnotationScope_AnnotationScopeEnum:      "google.protobuf.EnumOptions",
+	proto.AnnotationScope_AnnotationScopeEnumerant: "google.protobuf.EnumValueOptions",
+	proto.AnnotationScope_AnnotationScopeAPI:       "google.protobuf.ServiceOptions",
+	proto.AnnotationScope_AnnotationScopeAPIMethod: "google.protobuf.MethodOptions",
+}
+
+// IsOptionsMessage reports whether the given full name is that of one of the
+// protobuf options messages that annotations are converted to extensions of.
+func IsOptionsMessage(name string) bool {
+	for _, optionsMessage := range optionsMessages {
+		if optionsMessage == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Extendees returns the full names of the protobuf options messages that an
+// annotation is an extension of, one for each of its scopes that protobuf has
+// options for. Annotations of the built-in Protobuf module are converted to
+// the standard options instead, so they have none.
+func Extendees(annotation *proto.Annotation) []string {
+	if annotation.Reference.ModuleUID == ProtobufModuleUID {
+		return nil
+	}
+	var extendees []string
+	for _, scope := range optionsScopes {
+		for _, annotationScope := range annotation.Scopes {
+			if annotationScope == scope || annotationScope == proto.AnnotationScope_AnnotationScopeStar {
+				extendees = append(extendees, optionsMessages[scope])
+				break
+			}
+		}
+	}
+	return extendees
+}
+
+// ExtensionName returns the name of the extension of the given options
+// message that an annotation stands for. An annotation that extends more than
+// one options message is suffixed with the kind of options of each so that
+// the names of its extensions don't conflict.
+func ExtensionName(annotation *proto.Annotation, extendee string) string {
+	if len(Extendees(annotation)) < 2 {
+		return annotation.Name
+	}
+	return annotation.Name + "_" + strings.TrimSuffix(strings.TrimPrefix(extendee, "google.protobuf."), "Options")
+}
+
+// ExtensionNumber returns the field number of the protobuf extension that an
+// annotation stands for, which is the low 29 bits of the annotation's UID.
+// Annotations converted from extensions of the protobuf options messages are
+// given UIDs that preserve the extension's number this way.
+func ExtensionNumber(annotation *proto.TypeReference) int32 {
+	return int32(annotation.TypeUID & MaxFieldUID)
+}
+
+// IsExtension reports whether an annotation is converted to extensions of the
+// protobuf options messages, which requires that it has a scope that protobuf
+// has options for, a number that the options messages accept for their
+// extensions, and a type that an extension can have. Maps can't be
+// extensions, and neither can lists or presences of virtual types.
+func (i *Image) IsExtension(annotation *proto.Annotation) bool {
+	if len(Extendees(annotation)) < 1 {
+		return false
+	}
+	number := uint64(ExtensionNumber(annotation.Reference))
+	if number < FirstExtensionNumber || (number >= FirstImplementationFieldUID && number <= LastImplementationFieldUID) {
+		return false
+	}
+	resolved, ok := annotation.Type.Reference.(*proto.TypeSpecifier_Resolved)
+	if !ok {
+		return false
+	}
+	kind, declaration := i.Lookup(resolved.Resolved.Reference)
+	if kind == TypeKindVirtual && declaration.(*proto.Struct).Name.Name != "Map" {
+		resolved, ok = resolved.Resolved.Parameters[0].Reference.(*proto.TypeSpecifier_Resolved)
+		if !ok {
+			return false
+		}
+		kind, _ = i.Lookup(resolved.Resolved.Reference)
+	}
+	switch kind {
+	case TypeKindPrimitive, TypeKindData, TypeKindStruct, TypeKindEnum:
+		return true
+	}
+	return false
+}
+
+// fieldTypes maps the names that $(Protobuf.FieldType()) accepts to the
+// protobuf types that they select, which encode integers with zigzag or fixed
+// encodings, and to the built-in types that can be encoded that way.
+var fieldTypes = map[string]struct {
+	type_    descriptorpb.FieldDescriptorProto_Type
+	builtins []string
+}{
+	"sint32":   {descriptorpb.FieldDescriptorProto_TYPE_SINT32, []string{"Int8", "Int16", "Int32"}},
+	"sint64":   {descriptorpb.FieldDescriptorProto_TYPE_SINT64, []string{"Int64"}},
+	"sfixed32": {descriptorpb.FieldDescriptorProto_TYPE_SFIXED32, []string{"Int8", "Int16", "Int32"}},
+	"sfixed64": {descriptorpb.FieldDescriptorProto_TYPE_SFIXED64, []string{"Int64"}},
+	"fixed32":  {descriptorpb.FieldDescriptorProto_TYPE_FIXED32, []string{"UInt8", "UInt16", "UInt32"}},
+	"fixed64":  {descriptorpb.FieldDescriptorProto_TYPE_FIXED64, []string{"UInt64"}},
+}
+
+// FieldTypeName returns the name that $(Protobuf.FieldType()) uses for a
+// protobuf type, if the type is one that it can select.
+func FieldTypeName(type_ descriptorpb.FieldDescriptorProto_Type) (string, bool) {
+	for name, fieldType := range fieldTypes {
+		if fieldType.type_ == type_ {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// EditionName returns the name that $(Protobuf.Edition()) uses for a protobuf
+// edition, such as "2023" for EDITION_2023.
+func EditionName(edition descriptorpb.Edition) string {
+	return strings.TrimPrefix(edition.String(), "EDITION_")
+}
+
+// Edition returns the protobuf edition that $(Protobuf.Edition()) names.
+func Edition(name string) (descriptorpb.Edition, error) {
+	edition, ok := descriptorpb.Edition_value["EDITION_"+name]
+	if !ok {
+		return descriptorpb.Edition_EDITION_UNKNOWN, fmt.Errorf("unknown protobuf edition %q", name)
+	}
+	return descriptorpb.Edition(edition), nil
+}
+
+// ProtobufSyntax returns the syntax that a module is converted to protobuf
+// with, and its edition if the syntax is editions. Modules are converted to
+// proto3 unless $(Protobuf.Syntax()) or $(Protobuf.Edition()) choose otherwise,
+// and naming an edition implies the editions syntax.
+func ProtobufSyntax(module *proto.Module) (string, descriptorpb.Edition, error) {
+	syntax := getProtobufAnnotationString(module.AnnotationApplications, "Syntax")
+	edition := getProtobufAnnotationString(module.AnnotationApplications, "Edition")
+	switch {
+	case edition != nil && syntax != nil && *syntax != "editions":
+		return "", descriptorpb.Edition_EDITION_UNKNOWN, fmt.Errorf("module %s names the edition %s but has the syntax %s", module.URI, *edition, *syntax)
+	case edition != nil:
+		e, err := Edition(*edition)
+		if err != nil {
+			return "", descriptorpb.Edition_EDITION_UNKNOWN, err
+		}
+		return "editions", e, nil
+	case syntax == nil:
+		return "proto3", descriptorpb.Edition_EDITION_UNKNOWN, nil
+	case *syntax == "proto2", *syntax == "proto3":
+		return *syntax, descriptorpb.Edition_EDITION_UNKNOWN, nil
+	case *syntax == "editions":
+		return "", descriptorpb.Edition_EDITION_UNKNOWN, fmt.Errorf("module %s has the syntax editions but doesn't name its edition", module.URI)
+	}
+	return "", descriptorpb.Edition_EDITION_UNKNOWN, fmt.Errorf("unknown protobuf syntax %q", *syntax)
+}
+
+// SetProtobufSyntax replaces the $(Protobuf.Syntax()) and $(Protobuf.Edition())
+// of a module with the given syntax, or with the given edition if the syntax is
+// empty.
+func SetProtobufSyntax(module *proto.Module, syntax string, edition string) {
+	module.AnnotationApplications = slices.DeleteFunc(module.AnnotationApplications, func(annotationApplication *proto.AnnotationApplication) bool {
+		resolved, ok := annotationApplication.Annotation.Reference.(*proto.TypeSpecifier_Resolved)
+		if !ok || resolved.Resolved.Reference.ModuleUID != ProtobufModuleUID {
+			return false
+		}
+		typeUID := resolved.Resolved.Reference.TypeUID
+		return typeUID == PROTOBUF_TYPE_UIDS["Syntax"] || typeUID == PROTOBUF_TYPE_UIDS["Edition"]
+	})
+	name, value := "Syntax", syntax
+	if syntax == "" {
+		name, value = "Edition", edition
+	}
+	module.AnnotationApplications = append(module.AnnotationApplications, &proto.AnnotationApplication{
+		Annotation: &proto.TypeSpecifier{
+			Reference: &proto.TypeSpecifier_Resolved{
+				Resolved: &proto.ResolvedReference{
+					Reference: &proto.TypeReference{
+						ModuleUID: ProtobufModuleUID,
+						TypeUID:   PROTOBUF_TYPE_UIDS[name],
+					},
+				},
+			},
+		},
+		Value: textValue(value),
+	})
+}
+
+// EditionDefaults returns the features that an edition sets by default. Each
+// feature declares its defaults with the edition_defaults option, and the
+// default of an edition is the one of the latest edition that isn't later.
+func EditionDefaults(edition descriptorpb.Edition) *descriptorpb.FeatureSet {
+	defaults := new(descriptorpb.FeatureSet)
+	message := defaults.ProtoReflect()
+	fields := message.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i = i + 1 {
+		field := fields.Get(i)
+		if field.Kind() != protoreflect.EnumKind {
+			continue
+		}
+		var latest *descriptorpb.FieldOptions_EditionDefault
+		for _, editionDefault := range field.Options().(*descriptorpb.FieldOptions).GetEditionDefaults() {
+			if editionDefault.GetEdition() <= edition && (latest == nil || editionDefault.GetEdition() > latest.GetEdition()) {
+				latest = editionDefault
+			}
+		}
+		if latest == nil {
+			continue
+		}
+		if value := field.Enum().Values().ByName(protoreflect.Name(latest.GetValue())); value != nil {
+			message.Set(field, protoreflect.ValueOfEnum(value.Number()))
+		}
+	}
+	return defaults
+}
+
+// ResolveFeatures returns the features of a declaration of a file that uses an
+// edition, which are the defaults of the edition overridden by each of the
+// given features in turn, such as those of the file and then those of the
+// declaration.
+func ResolveFeatures(edition descriptorpb.Edition, features ...*descriptorpb.FeatureSet) *descriptorpb.FeatureSet {
+	resolved := EditionDefaults(edition)
+	for _, f := range features {
+		if f != nil {
+			pb.Merge(resolved, f)
+		}
+	}
+	return resolved
+}
+
+// FieldType returns the protobuf type that $(Protobuf.FieldType()) selects for
+// a field, or nil if the annotation isn't applied to it. The annotation applies
+// to fields of integer types, and to lists and presences of them.
+func (i *Image) FieldType(field *proto.Field) (*descriptorpb.FieldDescriptorProto_Type, error) {
+	value := GetProtobufAnnotation(field.AnnotationApplications, "FieldType")
+	if value == nil {
+		return nil, nil
+	}
+	name := value.GetText().GetValue()
+	fieldType, ok := fieldTypes[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown protobuf field type %q", name)
+	}
+	resolved, ok := field.Type.Reference.(*proto.TypeSpecifier_Resolved)
+	if !ok {
+		return nil, fmt.Errorf("field %s has an unresolved type", field.Name)
+	}
+	kind, declaration := i.Lookup(resolved.Resolved.Reference)
+	if kind == TypeKindVirtual && declaration.(*proto.Struct).Name.Name != "Map" {
+		if resolved, ok = resolved.Resolved.Parameters[0].Reference.(*proto.TypeSpecifier_Resolved); ok {
+			kind, declaration = i.Lookup(resolved.Resolved.Reference)
+		}
+	}
+	if kind != TypeKindPrimitive || !slices.Contains(fieldType.builtins, declaration.(*proto.Struct).Name.Name) {
+		return nil, fmt.Errorf("field %s has the protobuf field type %s, which only applies to %s", field.Name, name, strings.Join(fieldType.builtins, ", "))
+	}
+	return &fieldType.type_, nil
+}
+
+// CheckGroup returns an error if $(Protobuf.Group(true)) is applied to a field
+// that can't be a group, which is any field whose type isn't a struct or a list
+// or presence of structs.
+func (i *Image) CheckGroup(field *proto.Field) error {
+	if !GetProtobufAnnotation(field.AnnotationApplications, "Group").GetBool().GetValue() {
+		return nil
+	}
+	resolved, ok := field.Type.Reference.(*proto.TypeSpecifier_Resolved)
+	if !ok {
+		return fmt.Errorf("field %s has an unresolved type", field.Name)
+	}
+	kind, declaration := i.Lookup(resolved.Resolved.Reference)
+	if kind == TypeKindVirtual && declaration.(*proto.Struct).Name.Name != "Map" {
+		if resolved, ok = resolved.Resolved.Parameters[0].Reference.(*proto.TypeSpecifier_Resolved); ok {
+			kind, _ = i.Lookup(resolved.Resolved.Reference)
+		}
+	}
+	if kind != TypeKindStruct {
+		return fmt.Errorf("field %s is a group but its type isn't a struct", field.Name)
+	}
+	return nil
+}
+
 var PROTOBUF_TYPE_UIDS = map[string]uint64{
 	"Package":              1,
 	"NestedTypeInfo":       2,
@@ -15,6 +337,13 @@ var PROTOBUF_TYPE_UIDS = map[string]uint64{
 	"JsonName":             4,
 	"Proto3Optional":       5,
 	"EnumFromProto":        6,
+	"Syntax":               7,
+	"Required":             8,
+	"Group":                9,
+	"FieldType":            10,
+	"Edition":              11,
+	"ClosedEnum":           12,
+	"Packed":               13,
 }
 
 var PROTOBUF_IDL = fmt.Sprintf(`
@@ -33,14 +362,27 @@ struct NestedTypes {
 
 annotation Package(module) :Text @%d
 annotation NestedTypeInfo(struct, enum) :NestedTypes @%d
-annotation FileOptionsGoPackage(module) :Text @%d
 annotation JsonName(field) :Text @%d
 annotation Proto3Optional(field) :Bool @%d
 annotation EnumFromProto(enum) :Bool @%d
-`, PROTOBUF_TYPE_UIDS["Package"],
+annotation Syntax(module) :Text @%d
+annotation Required(field) :Bool @%d
+annotation Group(field) :Bool @%d
+annotation FieldType(field) :Text @%d
+annotation Edition(module) :Text @%d
+annotation ClosedEnum(enum) :Bool @%d
+annotation Packed(field) :Bool @%d
+%s`, PROTOBUF_TYPE_UIDS["Package"],
 	PROTOBUF_TYPE_UIDS["NestedTypeInfo"],
-	PROTOBUF_TYPE_UIDS["FileOptionsGoPackage"],
 	PROTOBUF_TYPE_UIDS["JsonName"],
 	PROTOBUF_TYPE_UIDS["Proto3Optional"],
 	PROTOBUF_TYPE_UIDS["EnumFromProto"],
+	PROTOBUF_TYPE_UIDS["Syntax"],
+	PROTOBUF_TYPE_UIDS["Required"],
+	PROTOBUF_TYPE_UIDS["Group"],
+	PROTOBUF_TYPE_UIDS["FieldType"],
+	PROTOBUF_TYPE_UIDS["Edition"],
+	PROTOBUF_TYPE_UIDS["ClosedEnum"],
+	PROTOBUF_TYPE_UIDS["Packed"],
+	optionAnnotationsIDL(),
 )
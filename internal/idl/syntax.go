@@ -0,0 +1,218 @@
+// © 2023 Microglot LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package idl
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	pb "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"gopkg.microglot.org/mglotc/internal/proto"
+)
+
+// BuiltinTypeName returns the name of the built-in type that a type specifier
+// resolves to, or "" if it resolves to any other type.
+func BuiltinTypeName(typeSpecifier *proto.TypeSpecifier) string {
+	resolved, ok := typeSpecifier.GetReference().(*proto.TypeSpecifier_Resolved)
+	if !ok || resolved.Resolved.Reference.ModuleUID != 0 {
+		return ""
+	}
+	name, ok := GetBuiltinTypeNameFromUID(resolved.Resolved.Reference.TypeUID)
+	if !ok {
+		return ""
+	}
+	return name.Name
+}
+
+// IsClosedEnum reports whether a type specifier resolves to an enum that is
+// closed, which are those of proto2 modules and those that have
+// $(Protobuf.ClosedEnum(true)).
+func (i *Image) IsClosedEnum(typeSpecifier *proto.TypeSpecifier) bool {
+	resolved, ok := typeSpecifier.GetReference().(*proto.TypeSpecifier_Resolved)
+	if !ok {
+		return false
+	}
+	for _, module := range i.Modules {
+		if module.UID != resolved.Resolved.Reference.ModuleUID {
+			continue
+		}
+		for _, enum := range module.Enums {
+			if enum.Reference.TypeUID == resolved.Resolved.Reference.TypeUID {
+				if closed := getProtobufAnnotationBool(enum.AnnotationApplications, "ClosedEnum"); closed != nil && *closed {
+					return true
+				}
+				syntax, _, _ := ProtobufSyntax(module)
+				return syntax == "proto2"
+			}
+		}
+	}
+	return false
+}
+
+// fromDefaultValue converts the default value of a field to the text that
+// protobuf uses for it. Enum values are given by the names they have in
+// protobuf, and Data by the C escapes that protoc uses. Text is kept as it
+// is, which is also how the defaults of bytes and enum fields are recorded
+// when they are converted from protobuf.
+func (c *imageConverter) fromDefaultValue(value *proto.Value) (string, error) {
+	switch kind := value.Kind.(type) {
+	case *proto.Value_Bool:
+		return strconv.FormatBool(kind.Bool.Value), nil
+	case *proto.Value_Text:
+		return kind.Text.Value, nil
+	case *proto.Value_Data:
+		return cEscape(kind.Data.Value), nil
+	case *proto.Value_Int8:
+		return strconv.FormatInt(int64(kind.Int8.Value), 10), nil
+	case *proto.Value_Int16:
+		return strconv.FormatInt(int64(kind.Int16.Value), 10), nil
+	case *proto.Value_Int32:
+		return strconv.FormatInt(int64(kind.Int32.Value), 10), nil
+	case *proto.Value_Int64:
+		return strconv.FormatInt(kind.Int64.Value, 10), nil
+	case *proto.Value_UInt8:
+		return strconv.FormatUint(uint64(kind.UInt8.Value), 10), nil
+	case *proto.Value_UInt16:
+		return strconv.FormatUint(uint64(kind.UInt16.Value), 10), nil
+	case *proto.Value_UInt32:
+		return strconv.FormatUint(uint64(kind.UInt32.Value), 10), nil
+	case *proto.Value_UInt64:
+		return strconv.FormatUint(kind.UInt64.Value, 10), nil
+	case *proto.Value_Float32:
+		return formatFloat(float64(kind.Float32.Value), 32), nil
+	case *proto.Value_Float64:
+		return formatFloat(kind.Float64.Value, 64), nil
+	case *proto.Value_Enumerant:
+		return c.enumerantName(kind.Enumerant)
+	case *proto.Value_Identifier:
+		if attribute := kind.Identifier.GetAttribute(); attribute != nil {
+			return c.enumerantName(attribute)
+		}
+	}
+	return "", fmt.Errorf("default value %s doesn't convert to protobuf", value)
+}
+
+// enumerantName returns the name that an enumerant has in protobuf, which is
+// prefixed with the name of its enum unless the enum came from protobuf.
+func (c *imageConverter) enumerantName(reference *proto.AttributeReference) (string, error) {
+	kind, declaration := c.image.Lookup(&proto.TypeReference{ModuleUID: reference.ModuleUID, TypeUID: reference.TypeUID})
+	if kind == TypeKindEnum {
+		enum := declaration.(*proto.Enum)
+		for _, enumerant := range enum.Enumerants {
+			if enumerant.Reference.AttributeUID != reference.AttributeUID {
+				continue
+			}
+			if fromProto := getProtobufAnnotationBool(enum.AnnotationApplications, "EnumFromProto"); fromProto != nil && *fromProto {
+				return enumerant.Name, nil
+			}
+			return enum.Name + "_" + enumerant.Name, nil
+		}
+	}
+	return "", fmt.Errorf("enumerant @%d of type @%d in module @%d doesn't exist", reference.AttributeUID, reference.TypeUID, reference.ModuleUID)
+}
+
+func formatFloat(value float64, bitSize int) string {
+	switch {
+	case math.IsInf(value, 1):
+		return "inf"
+	case math.IsInf(value, -1):
+		return "-inf"
+	case math.IsNaN(value):
+		return "nan"
+	}
+	return strconv.FormatFloat(value, 'g', -1, bitSize)
+}
+
+// cEscape escapes bytes the way that protoc escapes the defaults of bytes
+// fields.
+func cEscape(value []byte) string {
+	var b strings.Builder
+	for _, c := range value {
+		switch c {
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '"':
+			b.WriteString(`\"`)
+		case '\'':
+			b.WriteString(`\'`)
+		case '\\':
+			b.WriteString(`\\`)
+		default:
+			if c < 0x20 || c >= 0x7F {
+				fmt.Fprintf(&b, `\%03o`, c)
+			} else {
+				b.WriteByte(c)
+			}
+		}
+	}
+	return b.String()
+}
+
+// withFieldFeatures sets the features of a field of a module that uses
+// editions that its type and annotations call for, unless the features that
+// it inherits from the file already do. Presence<T> calls for explicit
+// presence, $(Protobuf.Required(true)) for legacy required presence, and
+// $(Protobuf.Group(true)) for delimited encoding. Other singular fields have
+// implicit presence, except for those that can't, which are those of
+// extensions, unions, structs and closed enums.
+func (c *imageConverter) withFieldFeatures(options *descriptorpb.FieldOptions, field *proto.Field, isExtension bool, label descriptorpb.FieldDescriptorProto_Label, type_ descriptorpb.FieldDescriptorProto_Type) *descriptorpb.FieldOptions {
+	resolved := ResolveFeatures(c.edition, c.fileFeatures, options.GetFeatures())
+	features := new(descriptorpb.FeatureSet)
+
+	presence := resolved.GetFieldPresence()
+	required := getProtobufAnnotationBool(field.AnnotationApplications, "Required")
+	switch {
+	case required != nil && *required:
+		presence = descriptorpb.FeatureSet_LEGACY_REQUIRED
+	case label == descriptorpb.FieldDescriptorProto_LABEL_REPEATED || field.UnionIndex != nil || type_ == descriptorpb.FieldDescriptorProto_TYPE_MESSAGE:
+	case BuiltinTypeName(field.Type) == "Presence":
+		presence = descriptorpb.FeatureSet_EXPLICIT
+	case !isExtension && !c.image.IsClosedEnum(field.Type):
+		presence = descriptorpb.FeatureSet_IMPLICIT
+	}
+	if presence != resolved.GetFieldPresence() {
+		features.FieldPresence = presence.Enum()
+	}
+	if group := getProtobufAnnotationBool(field.AnnotationApplications, "Group"); group != nil && *group && resolved.GetMessageEncoding() != descriptorpb.FeatureSet_DELIMITED {
+		features.MessageEncoding = descriptorpb.FeatureSet_DELIMITED.Enum()
+	}
+
+	if pb.Size(features) == 0 {
+		return options
+	}
+	if options == nil {
+		options = new(descriptorpb.FieldOptions)
+	}
+	if options.Features == nil {
+		options.Features = new(descriptorpb.FeatureSet)
+	}
+	pb.Merge(options.Features, features)
+	return options
+}
+
+// withEnumFeatures makes an enum of a module that uses editions closed if it
+// has $(Protobuf.ClosedEnum(true)), unless it inherits that from the file.
+func (c *imageConverter) withEnumFeatures(options *descriptorpb.EnumOptions, enum *proto.Enum) *descriptorpb.EnumOptions {
+	closed := getProtobufAnnotationBool(enum.AnnotationApplications, "ClosedEnum")
+	if closed == nil || !*closed || ResolveFeatures(c.edition, c.fileFeatures, options.GetFeatures()).GetEnumType() == descriptorpb.FeatureSet_CLOSED {
+		return options
+	}
+	if options == nil {
+		options = new(descriptorpb.EnumOptions)
+	}
+	if options.Features == nil {
+		options.Features = new(descriptorpb.FeatureSet)
+	}
+	options.Features.EnumType = descriptorpb.FeatureSet_CLOSED.Enum()
+	return options
+}
@@ -158,11 +158,24 @@ func (gen *Generator) Generate(targets []string) ([]*pluginpb.CodeGeneratorRespo
 					for _, api := range module.APIs {
 						g.P("// type ", api.Name.Name, " is the interface for ", api.Name.Name, "API.")
 						g.P("type ", api.Name.Name, " interface {")
+						// The checker rejects extends cycles and methods that
+						// are declared more than once across the extended set,
+						// so the embedded interfaces never conflict.
 						for _, ext := range api.Extends {
 							g.P("    ", gen.genType(module.UID, g, gen.image, ext))
 						}
 						for _, method := range api.Methods {
-							g.P("    ", method.Name, "(ctx context.Context, req ", gen.genType(module.UID, g, gen.image, method.Input), ") (", gen.genType(module.UID, g, gen.image, method.Output), ", error)")
+							// Streaming inputs and outputs are rendered as
+							// channels of the streamed type.
+							input := gen.genType(module.UID, g, gen.image, method.Input)
+							if method.InputStream {
+								input = "<-chan " + input
+							}
+							output := gen.genType(module.UID, g, gen.image, method.Output)
+							if method.OutputStream {
+								output = "<-chan " + output
+							}
+							g.P("    ", method.Name, "(ctx context.Context, req ", input, ") (", output, ", error)")
 						}
 						g.P("}")
 						g.P()
@@ -174,6 +187,8 @@ func (gen *Generator) Generate(targets []string) ([]*pluginpb.CodeGeneratorRespo
 					ifName := sdk.Name.Name
 					g.P("// type ", ifName, " is the interface for ", sdk.Name.Name, "SDK.")
 					g.P("type ", ifName, " interface {")
+					// As with APIs, the checker guarantees that embedding the
+					// extended SDKs is valid.
 					for _, ext := range sdk.Extends {
 						g.P("    ", gen.genType(module.UID, g, gen.image, ext))
 					}
@@ -323,6 +323,7 @@ type Module struct {
 	Constants              []*Constant              `protobuf:"bytes,10,rep,name=Constants,proto3" json:"Constants,omitempty"`
 	Annotations            []*Annotation            `protobuf:"bytes,11,rep,name=Annotations,proto3" json:"Annotations,omitempty"`
 	DotImports             []*DotImport             `protobuf:"bytes,12,rep,name=DotImports,proto3" json:"DotImports,omitempty"`
+	Extensions             []*Extension             `protobuf:"bytes,13,rep,name=Extensions,proto3" json:"Extensions,omitempty"`
 }
 
 func (x *Module) Reset() {
@@ -441,17 +442,30 @@ func (x *Module) GetDotImports() []*DotImport {
 	return nil
 }
 
+func (x *Module) GetExtensions() []*Extension {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
 type Import struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ModuleUID    uint64        `protobuf:"varint,1,opt,name=ModuleUID,proto3" json:"ModuleUID,omitempty"`
-	ImportedURI  string        `protobuf:"bytes,2,opt,name=ImportedURI,proto3" json:"ImportedURI,omitempty"`
-	ImportedUID  uint64        `protobuf:"varint,3,opt,name=ImportedUID,proto3" json:"ImportedUID,omitempty"`
-	Alias        string        `protobuf:"bytes,4,opt,name=Alias,proto3" json:"Alias,omitempty"`
-	IsDot        bool          `protobuf:"varint,5,opt,name=IsDot,proto3" json:"IsDot,omitempty"`
-	CommentBlock *CommentBlock `protobuf:"bytes,6,opt,name=CommentBlock,proto3" json:"CommentBlock,omitempty"`
+	ModuleUID    uint64          `protobuf:"varint,1,opt,name=ModuleUID,proto3" json:"ModuleUID,omitempty"`
+	ImportedURI  string          `protobuf:"bytes,2,opt,name=ImportedURI,proto3" json:"ImportedURI,omitempty"`
+	ImportedUID  uint64          `protobuf:"varint,3,opt,name=ImportedUID,proto3" json:"ImportedUID,omitempty"`
+	Alias        string          `protobuf:"bytes,4,opt,name=Alias,proto3" json:"Alias,omitempty"`
+	IsDot        bool            `protobuf:"varint,5,opt,name=IsDot,proto3" json:"IsDot,omitempty"`
+	CommentBlock *CommentBlock   `protobuf:"bytes,6,opt,name=CommentBlock,proto3" json:"CommentBlock,omitempty"`
+	Location     *SourceLocation `protobuf:"bytes,7,opt,name=Location,proto3" json:"Location,omitempty"`
+	// IsPublic and IsWeak record the public and weak imports of a protobuf
+	// file. The types of a public import are also visible to the files that
+	// import the importing file.
+	IsPublic bool `protobuf:"varint,8,opt,name=IsPublic,proto3" json:"IsPublic,omitempty"`
+	IsWeak   bool `protobuf:"varint,9,opt,name=IsWeak,proto3" json:"IsWeak,omitempty"`
 }
 
 func (x *Import) Reset() {
@@ -528,6 +542,27 @@ func (x *Import) GetCommentBlock() *CommentBlock {
 	return nil
 }
 
+func (x *Import) GetLocation() *SourceLocation {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *Import) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
+func (x *Import) GetIsWeak() bool {
+	if x != nil {
+		return x.IsWeak
+	}
+	return false
+}
+
 type DotImport struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -599,6 +634,10 @@ type Struct struct {
 	CommentBlock           *CommentBlock            `protobuf:"bytes,6,opt,name=CommentBlock,proto3" json:"CommentBlock,omitempty"`
 	AnnotationApplications []*AnnotationApplication `protobuf:"bytes,7,rep,name=AnnotationApplications,proto3" json:"AnnotationApplications,omitempty"`
 	IsSynthetic            bool                     `protobuf:"varint,8,opt,name=IsSynthetic,proto3" json:"IsSynthetic,omitempty"`
+	Location               *SourceLocation          `protobuf:"bytes,9,opt,name=Location,proto3" json:"Location,omitempty"`
+	ReservedNames          []string                 `protobuf:"bytes,10,rep,name=ReservedNames,proto3" json:"ReservedNames,omitempty"`
+	ExtensionRanges        []*ExtensionRange        `protobuf:"bytes,11,rep,name=ExtensionRanges,proto3" json:"ExtensionRanges,omitempty"`
+	Extensions             []*Extension             `protobuf:"bytes,12,rep,name=Extensions,proto3" json:"Extensions,omitempty"`
 }
 
 func (x *Struct) Reset() {
@@ -689,11 +728,41 @@ func (x *Struct) GetIsSynthetic() bool {
 	return false
 }
 
+func (x *Struct) GetLocation() *SourceLocation {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *Struct) GetReservedNames() []string {
+	if x != nil {
+		return x.ReservedNames
+	}
+	return nil
+}
+
+func (x *Struct) GetExtensionRanges() []*ExtensionRange {
+	if x != nil {
+		return x.ExtensionRanges
+	}
+	return nil
+}
+
+func (x *Struct) GetExtensions() []*Extension {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
 type ReservedRange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// ReservedRange is a range of UIDs that must not be used. Both Start and
+	// End are included in the range.
 	Start uint64 `protobuf:"varint,1,opt,name=Start,proto3" json:"Start,omitempty"`
 	End   uint64 `protobuf:"varint,2,opt,name=End,proto3" json:"End,omitempty"`
 }
@@ -744,6 +813,129 @@ func (x *ReservedRange) GetEnd() uint64 {
 	return 0
 }
 
+type ExtensionRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ExtensionRange is a range of UIDs that extensions of a struct may use.
+	// Both Start and End are included in the range.
+	Start    uint64          `protobuf:"varint,1,opt,name=Start,proto3" json:"Start,omitempty"`
+	End      uint64          `protobuf:"varint,2,opt,name=End,proto3" json:"End,omitempty"`
+	Location *SourceLocation `protobuf:"bytes,3,opt,name=Location,proto3" json:"Location,omitempty"`
+}
+
+func (x *ExtensionRange) Reset() {
+	*x = ExtensionRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_descriptor_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExtensionRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtensionRange) ProtoMessage() {}
+
+func (x *ExtensionRange) ProtoReflect() protoreflect.Message {
+	mi := &file_descriptor_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtensionRange.ProtoReflect.Descriptor instead.
+func (*ExtensionRange) Descriptor() ([]byte, []int) {
+	return file_descriptor_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ExtensionRange) GetStart() uint64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *ExtensionRange) GetEnd() uint64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *ExtensionRange) GetLocation() *SourceLocation {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type Extension struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Extension is a field of a struct that is declared outside of the struct,
+	// as with a protobuf extend block. Once linked, the Reference of the Field
+	// refers to the extended struct.
+	Extendee *TypeSpecifier `protobuf:"bytes,1,opt,name=Extendee,proto3" json:"Extendee,omitempty"`
+	Field    *Field         `protobuf:"bytes,2,opt,name=Field,proto3" json:"Field,omitempty"`
+}
+
+func (x *Extension) Reset() {
+	*x = Extension{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_descriptor_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Extension) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Extension) ProtoMessage() {}
+
+func (x *Extension) ProtoReflect() protoreflect.Message {
+	mi := &file_descriptor_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Extension.ProtoReflect.Descriptor instead.
+func (*Extension) Descriptor() ([]byte, []int) {
+	return file_descriptor_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Extension) GetExtendee() *TypeSpecifier {
+	if x != nil {
+		return x.Extendee
+	}
+	return nil
+}
+
+func (x *Extension) GetField() *Field {
+	if x != nil {
+		return x.Field
+	}
+	return nil
+}
+
 type Field struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -758,12 +950,13 @@ type Field struct {
 	UnionIndex             *uint64                  `protobuf:"varint,5,opt,name=UnionIndex,proto3,oneof" json:"UnionIndex,omitempty"`
 	CommentBlock           *CommentBlock            `protobuf:"bytes,6,opt,name=CommentBlock,proto3" json:"CommentBlock,omitempty"`
 	AnnotationApplications []*AnnotationApplication `protobuf:"bytes,7,rep,name=AnnotationApplications,proto3" json:"AnnotationApplications,omitempty"`
+	Location               *SourceLocation          `protobuf:"bytes,8,opt,name=Location,proto3" json:"Location,omitempty"`
 }
 
 func (x *Field) Reset() {
 	*x = Field{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[6]
+		mi := &file_descriptor_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -776,7 +969,7 @@ func (x *Field) String() string {
 func (*Field) ProtoMessage() {}
 
 func (x *Field) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[6]
+	mi := &file_descriptor_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -789,7 +982,7 @@ func (x *Field) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Field.ProtoReflect.Descriptor instead.
 func (*Field) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{6}
+	return file_descriptor_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Field) GetReference() *AttributeReference {
@@ -841,6 +1034,13 @@ func (x *Field) GetAnnotationApplications() []*AnnotationApplication {
 	return nil
 }
 
+func (x *Field) GetLocation() *SourceLocation {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
 type Union struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -850,12 +1050,13 @@ type Union struct {
 	Name                   string                   `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
 	CommentBlock           *CommentBlock            `protobuf:"bytes,3,opt,name=CommentBlock,proto3" json:"CommentBlock,omitempty"`
 	AnnotationApplications []*AnnotationApplication `protobuf:"bytes,4,rep,name=AnnotationApplications,proto3" json:"AnnotationApplications,omitempty"`
+	Location               *SourceLocation          `protobuf:"bytes,5,opt,name=Location,proto3" json:"Location,omitempty"`
 }
 
 func (x *Union) Reset() {
 	*x = Union{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[7]
+		mi := &file_descriptor_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -868,7 +1069,7 @@ func (x *Union) String() string {
 func (*Union) ProtoMessage() {}
 
 func (x *Union) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[7]
+	mi := &file_descriptor_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -881,7 +1082,7 @@ func (x *Union) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Union.ProtoReflect.Descriptor instead.
 func (*Union) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{7}
+	return file_descriptor_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Union) GetReference() *AttributeReference {
@@ -912,6 +1113,13 @@ func (x *Union) GetAnnotationApplications() []*AnnotationApplication {
 	return nil
 }
 
+func (x *Union) GetLocation() *SourceLocation {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
 type Enum struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -924,12 +1132,13 @@ type Enum struct {
 	ReservedNames          []string                 `protobuf:"bytes,5,rep,name=ReservedNames,proto3" json:"ReservedNames,omitempty"`
 	CommentBlock           *CommentBlock            `protobuf:"bytes,6,opt,name=CommentBlock,proto3" json:"CommentBlock,omitempty"`
 	AnnotationApplications []*AnnotationApplication `protobuf:"bytes,7,rep,name=AnnotationApplications,proto3" json:"AnnotationApplications,omitempty"`
+	Location               *SourceLocation          `protobuf:"bytes,8,opt,name=Location,proto3" json:"Location,omitempty"`
 }
 
 func (x *Enum) Reset() {
 	*x = Enum{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[8]
+		mi := &file_descriptor_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -942,7 +1151,7 @@ func (x *Enum) String() string {
 func (*Enum) ProtoMessage() {}
 
 func (x *Enum) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[8]
+	mi := &file_descriptor_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -955,7 +1164,7 @@ func (x *Enum) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Enum.ProtoReflect.Descriptor instead.
 func (*Enum) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{8}
+	return file_descriptor_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *Enum) GetReference() *TypeReference {
@@ -1007,6 +1216,13 @@ func (x *Enum) GetAnnotationApplications() []*AnnotationApplication {
 	return nil
 }
 
+func (x *Enum) GetLocation() *SourceLocation {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
 type Enumerant struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1016,12 +1232,13 @@ type Enumerant struct {
 	Name                   string                   `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
 	CommentBlock           *CommentBlock            `protobuf:"bytes,3,opt,name=CommentBlock,proto3" json:"CommentBlock,omitempty"`
 	AnnotationApplications []*AnnotationApplication `protobuf:"bytes,4,rep,name=AnnotationApplications,proto3" json:"AnnotationApplications,omitempty"`
+	Location               *SourceLocation          `protobuf:"bytes,5,opt,name=Location,proto3" json:"Location,omitempty"`
 }
 
 func (x *Enumerant) Reset() {
 	*x = Enumerant{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[9]
+		mi := &file_descriptor_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1034,7 +1251,7 @@ func (x *Enumerant) String() string {
 func (*Enumerant) ProtoMessage() {}
 
 func (x *Enumerant) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[9]
+	mi := &file_descriptor_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1047,7 +1264,7 @@ func (x *Enumerant) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Enumerant.ProtoReflect.Descriptor instead.
 func (*Enumerant) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{9}
+	return file_descriptor_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *Enumerant) GetReference() *AttributeReference {
@@ -1078,6 +1295,13 @@ func (x *Enumerant) GetAnnotationApplications() []*AnnotationApplication {
 	return nil
 }
 
+func (x *Enumerant) GetLocation() *SourceLocation {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
 type API struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1091,12 +1315,13 @@ type API struct {
 	ReservedNames          []string                 `protobuf:"bytes,6,rep,name=ReservedNames,proto3" json:"ReservedNames,omitempty"`
 	CommentBlock           *CommentBlock            `protobuf:"bytes,7,opt,name=CommentBlock,proto3" json:"CommentBlock,omitempty"`
 	AnnotationApplications []*AnnotationApplication `protobuf:"bytes,8,rep,name=AnnotationApplications,proto3" json:"AnnotationApplications,omitempty"`
+	Location               *SourceLocation          `protobuf:"bytes,9,opt,name=Location,proto3" json:"Location,omitempty"`
 }
 
 func (x *API) Reset() {
 	*x = API{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[10]
+		mi := &file_descriptor_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1109,7 +1334,7 @@ func (x *API) String() string {
 func (*API) ProtoMessage() {}
 
 func (x *API) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[10]
+	mi := &file_descriptor_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1122,7 +1347,7 @@ func (x *API) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use API.ProtoReflect.Descriptor instead.
 func (*API) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{10}
+	return file_descriptor_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *API) GetReference() *TypeReference {
@@ -1181,6 +1406,13 @@ func (x *API) GetAnnotationApplications() []*AnnotationApplication {
 	return nil
 }
 
+func (x *API) GetLocation() *SourceLocation {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
 type APIMethod struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1192,12 +1424,15 @@ type APIMethod struct {
 	Output                 *TypeSpecifier           `protobuf:"bytes,4,opt,name=Output,proto3" json:"Output,omitempty"`
 	CommentBlock           *CommentBlock            `protobuf:"bytes,5,opt,name=CommentBlock,proto3" json:"CommentBlock,omitempty"`
 	AnnotationApplications []*AnnotationApplication `protobuf:"bytes,6,rep,name=AnnotationApplications,proto3" json:"AnnotationApplications,omitempty"`
+	Location               *SourceLocation          `protobuf:"bytes,7,opt,name=Location,proto3" json:"Location,omitempty"`
+	InputStream            bool                     `protobuf:"varint,8,opt,name=InputStream,proto3" json:"InputStream,omitempty"`
+	OutputStream           bool                     `protobuf:"varint,9,opt,name=OutputStream,proto3" json:"OutputStream,omitempty"`
 }
 
 func (x *APIMethod) Reset() {
 	*x = APIMethod{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[11]
+		mi := &file_descriptor_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1210,7 +1445,7 @@ func (x *APIMethod) String() string {
 func (*APIMethod) ProtoMessage() {}
 
 func (x *APIMethod) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[11]
+	mi := &file_descriptor_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1223,7 +1458,7 @@ func (x *APIMethod) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use APIMethod.ProtoReflect.Descriptor instead.
 func (*APIMethod) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{11}
+	return file_descriptor_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *APIMethod) GetReference() *AttributeReference {
@@ -1268,6 +1503,27 @@ func (x *APIMethod) GetAnnotationApplications() []*AnnotationApplication {
 	return nil
 }
 
+func (x *APIMethod) GetLocation() *SourceLocation {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *APIMethod) GetInputStream() bool {
+	if x != nil {
+		return x.InputStream
+	}
+	return false
+}
+
+func (x *APIMethod) GetOutputStream() bool {
+	if x != nil {
+		return x.OutputStream
+	}
+	return false
+}
+
 type SDK struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1281,12 +1537,13 @@ type SDK struct {
 	ReservedNames          []string                 `protobuf:"bytes,6,rep,name=ReservedNames,proto3" json:"ReservedNames,omitempty"`
 	CommentBlock           *CommentBlock            `protobuf:"bytes,7,opt,name=CommentBlock,proto3" json:"CommentBlock,omitempty"`
 	AnnotationApplications []*AnnotationApplication `protobuf:"bytes,8,rep,name=AnnotationApplications,proto3" json:"AnnotationApplications,omitempty"`
+	Location               *SourceLocation          `protobuf:"bytes,9,opt,name=Location,proto3" json:"Location,omitempty"`
 }
 
 func (x *SDK) Reset() {
 	*x = SDK{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[12]
+		mi := &file_descriptor_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1299,7 +1556,7 @@ func (x *SDK) String() string {
 func (*SDK) ProtoMessage() {}
 
 func (x *SDK) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[12]
+	mi := &file_descriptor_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1312,7 +1569,7 @@ func (x *SDK) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SDK.ProtoReflect.Descriptor instead.
 func (*SDK) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{12}
+	return file_descriptor_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *SDK) GetReference() *TypeReference {
@@ -1371,6 +1628,13 @@ func (x *SDK) GetAnnotationApplications() []*AnnotationApplication {
 	return nil
 }
 
+func (x *SDK) GetLocation() *SourceLocation {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
 type SDKMethod struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1383,12 +1647,13 @@ type SDKMethod struct {
 	NoThrows               bool                     `protobuf:"varint,5,opt,name=NoThrows,proto3" json:"NoThrows,omitempty"`
 	CommentBlock           *CommentBlock            `protobuf:"bytes,6,opt,name=CommentBlock,proto3" json:"CommentBlock,omitempty"`
 	AnnotationApplications []*AnnotationApplication `protobuf:"bytes,7,rep,name=AnnotationApplications,proto3" json:"AnnotationApplications,omitempty"`
+	Location               *SourceLocation          `protobuf:"bytes,8,opt,name=Location,proto3" json:"Location,omitempty"`
 }
 
 func (x *SDKMethod) Reset() {
 	*x = SDKMethod{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[13]
+		mi := &file_descriptor_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1401,7 +1666,7 @@ func (x *SDKMethod) String() string {
 func (*SDKMethod) ProtoMessage() {}
 
 func (x *SDKMethod) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[13]
+	mi := &file_descriptor_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1414,7 +1679,7 @@ func (x *SDKMethod) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SDKMethod.ProtoReflect.Descriptor instead.
 func (*SDKMethod) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{13}
+	return file_descriptor_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *SDKMethod) GetReference() *AttributeReference {
@@ -1466,6 +1731,13 @@ func (x *SDKMethod) GetAnnotationApplications() []*AnnotationApplication {
 	return nil
 }
 
+func (x *SDKMethod) GetLocation() *SourceLocation {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
 type SDKMethodInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1474,12 +1746,13 @@ type SDKMethodInput struct {
 	Reference *SDKInputReference `protobuf:"bytes,1,opt,name=Reference,proto3" json:"Reference,omitempty"`
 	Name      string             `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
 	Type      *TypeSpecifier     `protobuf:"bytes,3,opt,name=Type,proto3" json:"Type,omitempty"`
+	Location  *SourceLocation    `protobuf:"bytes,4,opt,name=Location,proto3" json:"Location,omitempty"`
 }
 
 func (x *SDKMethodInput) Reset() {
 	*x = SDKMethodInput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[14]
+		mi := &file_descriptor_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1492,7 +1765,7 @@ func (x *SDKMethodInput) String() string {
 func (*SDKMethodInput) ProtoMessage() {}
 
 func (x *SDKMethodInput) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[14]
+	mi := &file_descriptor_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1505,7 +1778,7 @@ func (x *SDKMethodInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SDKMethodInput.ProtoReflect.Descriptor instead.
 func (*SDKMethodInput) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{14}
+	return file_descriptor_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *SDKMethodInput) GetReference() *SDKInputReference {
@@ -1529,6 +1802,13 @@ func (x *SDKMethodInput) GetType() *TypeSpecifier {
 	return nil
 }
 
+func (x *SDKMethodInput) GetLocation() *SourceLocation {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
 type SDKInputReference struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1543,7 +1823,7 @@ type SDKInputReference struct {
 func (x *SDKInputReference) Reset() {
 	*x = SDKInputReference{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[15]
+		mi := &file_descriptor_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1556,7 +1836,7 @@ func (x *SDKInputReference) String() string {
 func (*SDKInputReference) ProtoMessage() {}
 
 func (x *SDKInputReference) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[15]
+	mi := &file_descriptor_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1569,7 +1849,7 @@ func (x *SDKInputReference) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SDKInputReference.ProtoReflect.Descriptor instead.
 func (*SDKInputReference) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{15}
+	return file_descriptor_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *SDKInputReference) GetModuleUID() uint64 {
@@ -1610,12 +1890,13 @@ type Annotation struct {
 	Scopes                 []AnnotationScope `protobuf:"varint,3,rep,packed,name=Scopes,proto3,enum=AnnotationScope" json:"Scopes,omitempty"`
 	Type                   *TypeSpecifier    `protobuf:"bytes,4,opt,name=Type,proto3" json:"Type,omitempty"`
 	DescriptorCommentBlock *CommentBlock     `protobuf:"bytes,5,opt,name=DescriptorCommentBlock,proto3" json:"DescriptorCommentBlock,omitempty"`
+	Location               *SourceLocation   `protobuf:"bytes,6,opt,name=Location,proto3" json:"Location,omitempty"`
 }
 
 func (x *Annotation) Reset() {
 	*x = Annotation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[16]
+		mi := &file_descriptor_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1628,7 +1909,7 @@ func (x *Annotation) String() string {
 func (*Annotation) ProtoMessage() {}
 
 func (x *Annotation) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[16]
+	mi := &file_descriptor_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1641,7 +1922,7 @@ func (x *Annotation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Annotation.ProtoReflect.Descriptor instead.
 func (*Annotation) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{16}
+	return file_descriptor_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *Annotation) GetReference() *TypeReference {
@@ -1679,6 +1960,13 @@ func (x *Annotation) GetDescriptorCommentBlock() *CommentBlock {
 	return nil
 }
 
+func (x *Annotation) GetLocation() *SourceLocation {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
 type Constant struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1690,12 +1978,13 @@ type Constant struct {
 	Value                  *Value                   `protobuf:"bytes,4,opt,name=Value,proto3" json:"Value,omitempty"`
 	AnnotationApplications []*AnnotationApplication `protobuf:"bytes,5,rep,name=AnnotationApplications,proto3" json:"AnnotationApplications,omitempty"`
 	CommentBlock           *CommentBlock            `protobuf:"bytes,6,opt,name=CommentBlock,proto3" json:"CommentBlock,omitempty"`
+	Location               *SourceLocation          `protobuf:"bytes,7,opt,name=Location,proto3" json:"Location,omitempty"`
 }
 
 func (x *Constant) Reset() {
 	*x = Constant{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[17]
+		mi := &file_descriptor_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1708,7 +1997,7 @@ func (x *Constant) String() string {
 func (*Constant) ProtoMessage() {}
 
 func (x *Constant) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[17]
+	mi := &file_descriptor_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1721,7 +2010,7 @@ func (x *Constant) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Constant.ProtoReflect.Descriptor instead.
 func (*Constant) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{17}
+	return file_descriptor_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *Constant) GetReference() *TypeReference {
@@ -1766,19 +2055,27 @@ func (x *Constant) GetCommentBlock() *CommentBlock {
 	return nil
 }
 
+func (x *Constant) GetLocation() *SourceLocation {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
 type AnnotationApplication struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Annotation *TypeSpecifier `protobuf:"bytes,1,opt,name=Annotation,proto3" json:"Annotation,omitempty"`
-	Value      *Value         `protobuf:"bytes,2,opt,name=Value,proto3" json:"Value,omitempty"`
+	Annotation *TypeSpecifier  `protobuf:"bytes,1,opt,name=Annotation,proto3" json:"Annotation,omitempty"`
+	Value      *Value          `protobuf:"bytes,2,opt,name=Value,proto3" json:"Value,omitempty"`
+	Location   *SourceLocation `protobuf:"bytes,3,opt,name=Location,proto3" json:"Location,omitempty"`
 }
 
 func (x *AnnotationApplication) Reset() {
 	*x = AnnotationApplication{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[18]
+		mi := &file_descriptor_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1791,7 +2088,7 @@ func (x *AnnotationApplication) String() string {
 func (*AnnotationApplication) ProtoMessage() {}
 
 func (x *AnnotationApplication) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[18]
+	mi := &file_descriptor_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1804,7 +2101,7 @@ func (x *AnnotationApplication) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AnnotationApplication.ProtoReflect.Descriptor instead.
 func (*AnnotationApplication) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{18}
+	return file_descriptor_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *AnnotationApplication) GetAnnotation() *TypeSpecifier {
@@ -1821,6 +2118,13 @@ func (x *AnnotationApplication) GetValue() *Value {
 	return nil
 }
 
+func (x *AnnotationApplication) GetLocation() *SourceLocation {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
 type Value struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1842,18 +2146,20 @@ type Value struct {
 	//	*Value_Float32
 	//	*Value_Float64
 	//	*Value_List
+	//	*Value_Map
 	//	*Value_Struct
 	//	*Value_Enumerant
 	//	*Value_Identifier
 	//	*Value_Unary
 	//	*Value_Binary
-	Kind isValue_Kind `protobuf_oneof:"Kind"`
+	Kind     isValue_Kind    `protobuf_oneof:"Kind"`
+	Location *SourceLocation `protobuf:"bytes,22,opt,name=Location,proto3" json:"Location,omitempty"`
 }
 
 func (x *Value) Reset() {
 	*x = Value{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[19]
+		mi := &file_descriptor_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1866,7 +2172,7 @@ func (x *Value) String() string {
 func (*Value) ProtoMessage() {}
 
 func (x *Value) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[19]
+	mi := &file_descriptor_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1879,7 +2185,7 @@ func (x *Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Value.ProtoReflect.Descriptor instead.
 func (*Value) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{19}
+	return file_descriptor_proto_rawDescGZIP(), []int{21}
 }
 
 func (m *Value) GetKind() isValue_Kind {
@@ -1987,6 +2293,13 @@ func (x *Value) GetList() *ValueList {
 	return nil
 }
 
+func (x *Value) GetMap() *ValueMap {
+	if x, ok := x.GetKind().(*Value_Map); ok {
+		return x.Map
+	}
+	return nil
+}
+
 func (x *Value) GetStruct() *ValueStruct {
 	if x, ok := x.GetKind().(*Value_Struct); ok {
 		return x.Struct
@@ -2022,6 +2335,13 @@ func (x *Value) GetBinary() *ValueBinary {
 	return nil
 }
 
+func (x *Value) GetLocation() *SourceLocation {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
 type isValue_Kind interface {
 	isValue_Kind()
 }
@@ -2082,6 +2402,10 @@ type Value_List struct {
 	List *ValueList `protobuf:"bytes,15,opt,name=List,proto3,oneof"`
 }
 
+type Value_Map struct {
+	Map *ValueMap `protobuf:"bytes,16,opt,name=Map,proto3,oneof"`
+}
+
 type Value_Struct struct {
 	Struct *ValueStruct `protobuf:"bytes,17,opt,name=Struct,proto3,oneof"`
 }
@@ -2130,6 +2454,8 @@ func (*Value_Float64) isValue_Kind() {}
 
 func (*Value_List) isValue_Kind() {}
 
+func (*Value_Map) isValue_Kind() {}
+
 func (*Value_Struct) isValue_Kind() {}
 
 func (*Value_Enumerant) isValue_Kind() {}
@@ -2152,7 +2478,7 @@ type ValueBool struct {
 func (x *ValueBool) Reset() {
 	*x = ValueBool{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[20]
+		mi := &file_descriptor_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2165,7 +2491,7 @@ func (x *ValueBool) String() string {
 func (*ValueBool) ProtoMessage() {}
 
 func (x *ValueBool) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[20]
+	mi := &file_descriptor_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2178,7 +2504,7 @@ func (x *ValueBool) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValueBool.ProtoReflect.Descriptor instead.
 func (*ValueBool) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{20}
+	return file_descriptor_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ValueBool) GetValue() bool {
@@ -2207,7 +2533,7 @@ type ValueText struct {
 func (x *ValueText) Reset() {
 	*x = ValueText{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[21]
+		mi := &file_descriptor_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2220,7 +2546,7 @@ func (x *ValueText) String() string {
 func (*ValueText) ProtoMessage() {}
 
 func (x *ValueText) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[21]
+	mi := &file_descriptor_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2233,7 +2559,7 @@ func (x *ValueText) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValueText.ProtoReflect.Descriptor instead.
 func (*ValueText) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{21}
+	return file_descriptor_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ValueText) GetValue() string {
@@ -2262,7 +2588,7 @@ type ValueData struct {
 func (x *ValueData) Reset() {
 	*x = ValueData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[22]
+		mi := &file_descriptor_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2275,7 +2601,7 @@ func (x *ValueData) String() string {
 func (*ValueData) ProtoMessage() {}
 
 func (x *ValueData) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[22]
+	mi := &file_descriptor_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2288,7 +2614,7 @@ func (x *ValueData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValueData.ProtoReflect.Descriptor instead.
 func (*ValueData) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{22}
+	return file_descriptor_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ValueData) GetValue() []byte {
@@ -2317,7 +2643,7 @@ type ValueInt8 struct {
 func (x *ValueInt8) Reset() {
 	*x = ValueInt8{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[23]
+		mi := &file_descriptor_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2330,7 +2656,7 @@ func (x *ValueInt8) String() string {
 func (*ValueInt8) ProtoMessage() {}
 
 func (x *ValueInt8) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[23]
+	mi := &file_descriptor_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2343,7 +2669,7 @@ func (x *ValueInt8) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValueInt8.ProtoReflect.Descriptor instead.
 func (*ValueInt8) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{23}
+	return file_descriptor_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ValueInt8) GetValue() int32 {
@@ -2372,7 +2698,7 @@ type ValueInt16 struct {
 func (x *ValueInt16) Reset() {
 	*x = ValueInt16{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[24]
+		mi := &file_descriptor_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2385,7 +2711,7 @@ func (x *ValueInt16) String() string {
 func (*ValueInt16) ProtoMessage() {}
 
 func (x *ValueInt16) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[24]
+	mi := &file_descriptor_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2398,7 +2724,7 @@ func (x *ValueInt16) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValueInt16.ProtoReflect.Descriptor instead.
 func (*ValueInt16) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{24}
+	return file_descriptor_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *ValueInt16) GetValue() int32 {
@@ -2427,7 +2753,7 @@ type ValueInt32 struct {
 func (x *ValueInt32) Reset() {
 	*x = ValueInt32{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[25]
+		mi := &file_descriptor_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2440,7 +2766,7 @@ func (x *ValueInt32) String() string {
 func (*ValueInt32) ProtoMessage() {}
 
 func (x *ValueInt32) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[25]
+	mi := &file_descriptor_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2453,7 +2779,7 @@ func (x *ValueInt32) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValueInt32.ProtoReflect.Descriptor instead.
 func (*ValueInt32) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{25}
+	return file_descriptor_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ValueInt32) GetValue() int32 {
@@ -2482,7 +2808,7 @@ type ValueInt64 struct {
 func (x *ValueInt64) Reset() {
 	*x = ValueInt64{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[26]
+		mi := &file_descriptor_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2495,7 +2821,7 @@ func (x *ValueInt64) String() string {
 func (*ValueInt64) ProtoMessage() {}
 
 func (x *ValueInt64) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[26]
+	mi := &file_descriptor_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2508,7 +2834,7 @@ func (x *ValueInt64) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValueInt64.ProtoReflect.Descriptor instead.
 func (*ValueInt64) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{26}
+	return file_descriptor_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *ValueInt64) GetValue() int64 {
@@ -2537,7 +2863,7 @@ type ValueUInt8 struct {
 func (x *ValueUInt8) Reset() {
 	*x = ValueUInt8{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[27]
+		mi := &file_descriptor_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2550,7 +2876,7 @@ func (x *ValueUInt8) String() string {
 func (*ValueUInt8) ProtoMessage() {}
 
 func (x *ValueUInt8) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[27]
+	mi := &file_descriptor_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2563,7 +2889,7 @@ func (x *ValueUInt8) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValueUInt8.ProtoReflect.Descriptor instead.
 func (*ValueUInt8) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{27}
+	return file_descriptor_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *ValueUInt8) GetValue() uint32 {
@@ -2592,7 +2918,7 @@ type ValueUInt16 struct {
 func (x *ValueUInt16) Reset() {
 	*x = ValueUInt16{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[28]
+		mi := &file_descriptor_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2605,7 +2931,7 @@ func (x *ValueUInt16) String() string {
 func (*ValueUInt16) ProtoMessage() {}
 
 func (x *ValueUInt16) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[28]
+	mi := &file_descriptor_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2618,7 +2944,7 @@ func (x *ValueUInt16) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValueUInt16.ProtoReflect.Descriptor instead.
 func (*ValueUInt16) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{28}
+	return file_descriptor_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *ValueUInt16) GetValue() uint32 {
@@ -2647,7 +2973,7 @@ type ValueUInt32 struct {
 func (x *ValueUInt32) Reset() {
 	*x = ValueUInt32{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[29]
+		mi := &file_descriptor_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2660,7 +2986,7 @@ func (x *ValueUInt32) String() string {
 func (*ValueUInt32) ProtoMessage() {}
 
 func (x *ValueUInt32) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[29]
+	mi := &file_descriptor_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2673,7 +2999,7 @@ func (x *ValueUInt32) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValueUInt32.ProtoReflect.Descriptor instead.
 func (*ValueUInt32) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{29}
+	return file_descriptor_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *ValueUInt32) GetValue() uint32 {
@@ -2702,7 +3028,7 @@ type ValueUInt64 struct {
 func (x *ValueUInt64) Reset() {
 	*x = ValueUInt64{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[30]
+		mi := &file_descriptor_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2715,7 +3041,7 @@ func (x *ValueUInt64) String() string {
 func (*ValueUInt64) ProtoMessage() {}
 
 func (x *ValueUInt64) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[30]
+	mi := &file_descriptor_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2728,7 +3054,7 @@ func (x *ValueUInt64) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValueUInt64.ProtoReflect.Descriptor instead.
 func (*ValueUInt64) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{30}
+	return file_descriptor_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *ValueUInt64) GetValue() uint64 {
@@ -2757,7 +3083,7 @@ type ValueFloat32 struct {
 func (x *ValueFloat32) Reset() {
 	*x = ValueFloat32{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[31]
+		mi := &file_descriptor_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2770,7 +3096,7 @@ func (x *ValueFloat32) String() string {
 func (*ValueFloat32) ProtoMessage() {}
 
 func (x *ValueFloat32) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[31]
+	mi := &file_descriptor_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2783,7 +3109,7 @@ func (x *ValueFloat32) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValueFloat32.ProtoReflect.Descriptor instead.
 func (*ValueFloat32) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{31}
+	return file_descriptor_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *ValueFloat32) GetValue() float32 {
@@ -2812,7 +3138,7 @@ type ValueFloat64 struct {
 func (x *ValueFloat64) Reset() {
 	*x = ValueFloat64{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[32]
+		mi := &file_descriptor_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2825,7 +3151,7 @@ func (x *ValueFloat64) String() string {
 func (*ValueFloat64) ProtoMessage() {}
 
 func (x *ValueFloat64) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[32]
+	mi := &file_descriptor_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2838,7 +3164,7 @@ func (x *ValueFloat64) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValueFloat64.ProtoReflect.Descriptor instead.
 func (*ValueFloat64) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{32}
+	return file_descriptor_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *ValueFloat64) GetValue() float64 {
@@ -2871,7 +3197,7 @@ type ValueIdentifier struct {
 func (x *ValueIdentifier) Reset() {
 	*x = ValueIdentifier{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[33]
+		mi := &file_descriptor_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2884,7 +3210,7 @@ func (x *ValueIdentifier) String() string {
 func (*ValueIdentifier) ProtoMessage() {}
 
 func (x *ValueIdentifier) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[33]
+	mi := &file_descriptor_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2897,7 +3223,7 @@ func (x *ValueIdentifier) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValueIdentifier.ProtoReflect.Descriptor instead.
 func (*ValueIdentifier) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{33}
+	return file_descriptor_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *ValueIdentifier) GetNames() []string {
@@ -2955,7 +3281,7 @@ type ValueList struct {
 func (x *ValueList) Reset() {
 	*x = ValueList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[34]
+		mi := &file_descriptor_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2968,7 +3294,7 @@ func (x *ValueList) String() string {
 func (*ValueList) ProtoMessage() {}
 
 func (x *ValueList) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[34]
+	mi := &file_descriptor_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2981,7 +3307,7 @@ func (x *ValueList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValueList.ProtoReflect.Descriptor instead.
 func (*ValueList) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{34}
+	return file_descriptor_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *ValueList) GetElements() []*Value {
@@ -2991,6 +3317,108 @@ func (x *ValueList) GetElements() []*Value {
 	return nil
 }
 
+type ValueMap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*ValueMapEntry `protobuf:"bytes,1,rep,name=Entries,proto3" json:"Entries,omitempty"`
+}
+
+func (x *ValueMap) Reset() {
+	*x = ValueMap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_descriptor_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValueMap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValueMap) ProtoMessage() {}
+
+func (x *ValueMap) ProtoReflect() protoreflect.Message {
+	mi := &file_descriptor_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValueMap.ProtoReflect.Descriptor instead.
+func (*ValueMap) Descriptor() ([]byte, []int) {
+	return file_descriptor_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ValueMap) GetEntries() []*ValueMapEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type ValueMapEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   *Value `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	Value *Value `protobuf:"bytes,2,opt,name=Value,proto3" json:"Value,omitempty"`
+}
+
+func (x *ValueMapEntry) Reset() {
+	*x = ValueMapEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_descriptor_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValueMapEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValueMapEntry) ProtoMessage() {}
+
+func (x *ValueMapEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_descriptor_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValueMapEntry.ProtoReflect.Descriptor instead.
+func (*ValueMapEntry) Descriptor() ([]byte, []int) {
+	return file_descriptor_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ValueMapEntry) GetKey() *Value {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *ValueMapEntry) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
 type ValueStruct struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3002,7 +3430,7 @@ type ValueStruct struct {
 func (x *ValueStruct) Reset() {
 	*x = ValueStruct{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[35]
+		mi := &file_descriptor_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3015,7 +3443,7 @@ func (x *ValueStruct) String() string {
 func (*ValueStruct) ProtoMessage() {}
 
 func (x *ValueStruct) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[35]
+	mi := &file_descriptor_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3028,7 +3456,7 @@ func (x *ValueStruct) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValueStruct.ProtoReflect.Descriptor instead.
 func (*ValueStruct) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{35}
+	return file_descriptor_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *ValueStruct) GetFields() []*ValueStructField {
@@ -3050,7 +3478,7 @@ type ValueStructField struct {
 func (x *ValueStructField) Reset() {
 	*x = ValueStructField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[36]
+		mi := &file_descriptor_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3063,7 +3491,7 @@ func (x *ValueStructField) String() string {
 func (*ValueStructField) ProtoMessage() {}
 
 func (x *ValueStructField) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[36]
+	mi := &file_descriptor_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3076,7 +3504,7 @@ func (x *ValueStructField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValueStructField.ProtoReflect.Descriptor instead.
 func (*ValueStructField) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{36}
+	return file_descriptor_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *ValueStructField) GetName() string {
@@ -3105,7 +3533,7 @@ type ValueUnary struct {
 func (x *ValueUnary) Reset() {
 	*x = ValueUnary{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[37]
+		mi := &file_descriptor_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3118,7 +3546,7 @@ func (x *ValueUnary) String() string {
 func (*ValueUnary) ProtoMessage() {}
 
 func (x *ValueUnary) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[37]
+	mi := &file_descriptor_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3131,7 +3559,7 @@ func (x *ValueUnary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValueUnary.ProtoReflect.Descriptor instead.
 func (*ValueUnary) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{37}
+	return file_descriptor_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *ValueUnary) GetOperation() OperationUnary {
@@ -3161,7 +3589,7 @@ type ValueBinary struct {
 func (x *ValueBinary) Reset() {
 	*x = ValueBinary{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[38]
+		mi := &file_descriptor_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3174,7 +3602,7 @@ func (x *ValueBinary) String() string {
 func (*ValueBinary) ProtoMessage() {}
 
 func (x *ValueBinary) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[38]
+	mi := &file_descriptor_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3187,7 +3615,7 @@ func (x *ValueBinary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValueBinary.ProtoReflect.Descriptor instead.
 func (*ValueBinary) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{38}
+	return file_descriptor_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *ValueBinary) GetOperation() OperationBinary {
@@ -3223,7 +3651,7 @@ type TypeReference struct {
 func (x *TypeReference) Reset() {
 	*x = TypeReference{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[39]
+		mi := &file_descriptor_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3236,7 +3664,7 @@ func (x *TypeReference) String() string {
 func (*TypeReference) ProtoMessage() {}
 
 func (x *TypeReference) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[39]
+	mi := &file_descriptor_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3249,7 +3677,7 @@ func (x *TypeReference) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TypeReference.ProtoReflect.Descriptor instead.
 func (*TypeReference) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{39}
+	return file_descriptor_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *TypeReference) GetModuleUID() uint64 {
@@ -3276,12 +3704,13 @@ type TypeSpecifier struct {
 	//	*TypeSpecifier_Forward
 	//	*TypeSpecifier_Resolved
 	Reference isTypeSpecifier_Reference `protobuf_oneof:"Reference"`
+	Location  *SourceLocation           `protobuf:"bytes,3,opt,name=Location,proto3" json:"Location,omitempty"`
 }
 
 func (x *TypeSpecifier) Reset() {
 	*x = TypeSpecifier{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[40]
+		mi := &file_descriptor_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3294,7 +3723,7 @@ func (x *TypeSpecifier) String() string {
 func (*TypeSpecifier) ProtoMessage() {}
 
 func (x *TypeSpecifier) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[40]
+	mi := &file_descriptor_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3307,7 +3736,7 @@ func (x *TypeSpecifier) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TypeSpecifier.ProtoReflect.Descriptor instead.
 func (*TypeSpecifier) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{40}
+	return file_descriptor_proto_rawDescGZIP(), []int{44}
 }
 
 func (m *TypeSpecifier) GetReference() isTypeSpecifier_Reference {
@@ -3331,6 +3760,13 @@ func (x *TypeSpecifier) GetResolved() *ResolvedReference {
 	return nil
 }
 
+func (x *TypeSpecifier) GetLocation() *SourceLocation {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
 type isTypeSpecifier_Reference interface {
 	isTypeSpecifier_Reference()
 }
@@ -3362,7 +3798,7 @@ type ForwardReference struct {
 func (x *ForwardReference) Reset() {
 	*x = ForwardReference{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[41]
+		mi := &file_descriptor_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3375,7 +3811,7 @@ func (x *ForwardReference) String() string {
 func (*ForwardReference) ProtoMessage() {}
 
 func (x *ForwardReference) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[41]
+	mi := &file_descriptor_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3388,7 +3824,7 @@ func (x *ForwardReference) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ForwardReference.ProtoReflect.Descriptor instead.
 func (*ForwardReference) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{41}
+	return file_descriptor_proto_rawDescGZIP(), []int{45}
 }
 
 func (m *ForwardReference) GetReference() isForwardReference_Reference {
@@ -3440,7 +3876,7 @@ type MicroglotForwardReference struct {
 func (x *MicroglotForwardReference) Reset() {
 	*x = MicroglotForwardReference{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[42]
+		mi := &file_descriptor_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3453,7 +3889,7 @@ func (x *MicroglotForwardReference) String() string {
 func (*MicroglotForwardReference) ProtoMessage() {}
 
 func (x *MicroglotForwardReference) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[42]
+	mi := &file_descriptor_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3466,7 +3902,7 @@ func (x *MicroglotForwardReference) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MicroglotForwardReference.ProtoReflect.Descriptor instead.
 func (*MicroglotForwardReference) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{42}
+	return file_descriptor_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *MicroglotForwardReference) GetQualifier() string {
@@ -3495,7 +3931,7 @@ type ResolvedReference struct {
 func (x *ResolvedReference) Reset() {
 	*x = ResolvedReference{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[43]
+		mi := &file_descriptor_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3508,7 +3944,7 @@ func (x *ResolvedReference) String() string {
 func (*ResolvedReference) ProtoMessage() {}
 
 func (x *ResolvedReference) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[43]
+	mi := &file_descriptor_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3521,7 +3957,7 @@ func (x *ResolvedReference) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResolvedReference.ProtoReflect.Descriptor instead.
 func (*ResolvedReference) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{43}
+	return file_descriptor_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *ResolvedReference) GetReference() *TypeReference {
@@ -3551,7 +3987,7 @@ type AttributeReference struct {
 func (x *AttributeReference) Reset() {
 	*x = AttributeReference{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[44]
+		mi := &file_descriptor_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3564,7 +4000,7 @@ func (x *AttributeReference) String() string {
 func (*AttributeReference) ProtoMessage() {}
 
 func (x *AttributeReference) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[44]
+	mi := &file_descriptor_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3577,7 +4013,7 @@ func (x *AttributeReference) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AttributeReference.ProtoReflect.Descriptor instead.
 func (*AttributeReference) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{44}
+	return file_descriptor_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *AttributeReference) GetModuleUID() uint64 {
@@ -3612,7 +4048,7 @@ type CommentBlock struct {
 func (x *CommentBlock) Reset() {
 	*x = CommentBlock{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[45]
+		mi := &file_descriptor_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3625,7 +4061,7 @@ func (x *CommentBlock) String() string {
 func (*CommentBlock) ProtoMessage() {}
 
 func (x *CommentBlock) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[45]
+	mi := &file_descriptor_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3638,7 +4074,7 @@ func (x *CommentBlock) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommentBlock.ProtoReflect.Descriptor instead.
 func (*CommentBlock) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{45}
+	return file_descriptor_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *CommentBlock) GetLines() []string {
@@ -3660,7 +4096,7 @@ type TypeName struct {
 func (x *TypeName) Reset() {
 	*x = TypeName{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[46]
+		mi := &file_descriptor_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3673,7 +4109,7 @@ func (x *TypeName) String() string {
 func (*TypeName) ProtoMessage() {}
 
 func (x *TypeName) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[46]
+	mi := &file_descriptor_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3686,7 +4122,7 @@ func (x *TypeName) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TypeName.ProtoReflect.Descriptor instead.
 func (*TypeName) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{46}
+	return file_descriptor_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *TypeName) GetName() string {
@@ -3703,13 +4139,272 @@ func (x *TypeName) GetParameters() []*TypeSpecifier {
 	return nil
 }
 
+// PluginRequest is written to the stdin of a native microglot plugin. Unlike
+// the protoc plugin protocol, it carries the complete image so that plugins
+// have access to SDKs, constants, and annotations.
+type PluginRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Image *Image `protobuf:"bytes,1,opt,name=Image,proto3" json:"Image,omitempty"`
+	// The URIs of the modules in the image that the plugin should generate
+	// output for. All other modules are present only as dependencies.
+	FilesToGenerate []string `protobuf:"bytes,2,rep,name=FilesToGenerate,proto3" json:"FilesToGenerate,omitempty"`
+	// The plugin parameter string given on the command line, if any.
+	Parameter       string `protobuf:"bytes,3,opt,name=Parameter,proto3" json:"Parameter,omitempty"`
+	CompilerVersion string `protobuf:"bytes,4,opt,name=CompilerVersion,proto3" json:"CompilerVersion,omitempty"`
+}
+
+func (x *PluginRequest) Reset() {
+	*x = PluginRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_descriptor_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PluginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PluginRequest) ProtoMessage() {}
+
+func (x *PluginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_descriptor_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PluginRequest.ProtoReflect.Descriptor instead.
+func (*PluginRequest) Descriptor() ([]byte, []int) {
+	return file_descriptor_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *PluginRequest) GetImage() *Image {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+func (x *PluginRequest) GetFilesToGenerate() []string {
+	if x != nil {
+		return x.FilesToGenerate
+	}
+	return nil
+}
+
+func (x *PluginRequest) GetParameter() string {
+	if x != nil {
+		return x.Parameter
+	}
+	return ""
+}
+
+func (x *PluginRequest) GetCompilerVersion() string {
+	if x != nil {
+		return x.CompilerVersion
+	}
+	return ""
+}
+
+// PluginResponse is read from the stdout of a native microglot plugin.
+type PluginResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A non-empty Error indicates that the plugin failed to generate output.
+	Error string                `protobuf:"bytes,1,opt,name=Error,proto3" json:"Error,omitempty"`
+	Files []*PluginResponseFile `protobuf:"bytes,2,rep,name=Files,proto3" json:"Files,omitempty"`
+}
+
+func (x *PluginResponse) Reset() {
+	*x = PluginResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_descriptor_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PluginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PluginResponse) ProtoMessage() {}
+
+func (x *PluginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_descriptor_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PluginResponse.ProtoReflect.Descriptor instead.
+func (*PluginResponse) Descriptor() ([]byte, []int) {
+	return file_descriptor_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *PluginResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *PluginResponse) GetFiles() []*PluginResponseFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type PluginResponseFile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A path relative to the output directory.
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	// The content is bytes rather than a string so that plugins can generate
+	// binary files.
+	Content []byte `protobuf:"bytes,2,opt,name=Content,proto3" json:"Content,omitempty"`
+}
+
+func (x *PluginResponseFile) Reset() {
+	*x = PluginResponseFile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_descriptor_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PluginResponseFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PluginResponseFile) ProtoMessage() {}
+
+func (x *PluginResponseFile) ProtoReflect() protoreflect.Message {
+	mi := &file_descriptor_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PluginResponseFile.ProtoReflect.Descriptor instead.
+func (*PluginResponseFile) Descriptor() ([]byte, []int) {
+	return file_descriptor_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *PluginResponseFile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PluginResponseFile) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+// SourceLocation is a position within the source file of the module that
+// contains an element. Line and Column follow the same conventions as the
+// diagnostics that are reported while parsing the file. Offset is a byte
+// offset and is zero when the source format does not provide one.
+type SourceLocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Line   int32 `protobuf:"varint,1,opt,name=Line,proto3" json:"Line,omitempty"`
+	Column int32 `protobuf:"varint,2,opt,name=Column,proto3" json:"Column,omitempty"`
+	Offset int64 `protobuf:"varint,3,opt,name=Offset,proto3" json:"Offset,omitempty"`
+}
+
+func (x *SourceLocation) Reset() {
+	*x = SourceLocation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_descriptor_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SourceLocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SourceLocation) ProtoMessage() {}
+
+func (x *SourceLocation) ProtoReflect() protoreflect.Message {
+	mi := &file_descriptor_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SourceLocation.ProtoReflect.Descriptor instead.
+func (*SourceLocation) Descriptor() ([]byte, []int) {
+	return file_descriptor_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SourceLocation) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *SourceLocation) GetColumn() int32 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+func (x *SourceLocation) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
 var File_descriptor_proto protoreflect.FileDescriptor
 
 var file_descriptor_proto_rawDesc = []byte{
 	0x0a, 0x10, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x22, 0x2a, 0x0a, 0x05, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x07, 0x4d,
 	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4d,
-	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x07, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x22, 0xc1,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x07, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x22, 0xed,
 	0x03, 0x0a, 0x06, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x52, 0x49,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x55, 0x52, 0x49, 0x12, 0x10, 0x0a, 0x03, 0x55,
 	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x55, 0x49, 0x44, 0x12, 0x28, 0x0a,
@@ -3738,73 +4433,148 @@ var file_descriptor_proto_rawDesc = []byte{
 	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x0a, 0x0a, 0x44, 0x6f, 0x74, 0x49, 0x6d, 0x70,
 	0x6f, 0x72, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x44, 0x6f, 0x74,
 	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x0a, 0x44, 0x6f, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72,
-	0x74, 0x73, 0x22, 0xc9, 0x01, 0x0a, 0x06, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1c, 0x0a,
-	0x09, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x09, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x55, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x49,
-	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x55, 0x52, 0x49, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x55, 0x52, 0x49, 0x12, 0x20, 0x0a,
-	0x0b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x55, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x55, 0x49, 0x44, 0x12,
-	0x14, 0x0a, 0x05, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x73, 0x44, 0x6f, 0x74, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x49, 0x73, 0x44, 0x6f, 0x74, 0x12, 0x31, 0x0a, 0x0c, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x52, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x4d,
-	0x0a, 0x09, 0x44, 0x6f, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x2c, 0x0a, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x52, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xe6, 0x02,
-	0x0a, 0x06, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x12, 0x2c, 0x0a, 0x09, 0x52, 0x65, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x52, 0x65, 0x66,
-	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x52,
-	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x46,
-	0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x06, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x55,
-	0x6e, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x64, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x64, 0x12, 0x31, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x4e, 0x0a, 0x16, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x16, 0x41, 0x6e,
-	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x49, 0x73, 0x53, 0x79, 0x6e, 0x74, 0x68, 0x65,
-	0x74, 0x69, 0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x49, 0x73, 0x53, 0x79, 0x6e,
-	0x74, 0x68, 0x65, 0x74, 0x69, 0x63, 0x22, 0x37, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x64, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a,
-	0x03, 0x45, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x45, 0x6e, 0x64, 0x22,
-	0xd5, 0x02, 0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x31, 0x0a, 0x09, 0x52, 0x65, 0x66,
-	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x41,
-	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x52, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x22, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
-	0x2e, 0x54, 0x79, 0x70, 0x65, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x04,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x2a, 0x0a, 0x0c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x0c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x12, 0x23, 0x0a, 0x0a, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x0a, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64,
-	0x65, 0x78, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0c, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x4e, 0x0a, 0x16, 0x41, 0x6e, 0x6e, 0x6f,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74,
+	0x74, 0x73, 0x12, 0x2a, 0x0a, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xaa,
+	0x02, 0x0a, 0x06, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x4d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x4d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x55, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x55, 0x52, 0x49, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x55, 0x52, 0x49, 0x12, 0x20, 0x0a, 0x0b, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x64, 0x55, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x55, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x41,
+	0x6c, 0x69, 0x61, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x41, 0x6c, 0x69, 0x61,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x73, 0x44, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x49, 0x73, 0x44, 0x6f, 0x74, 0x12, 0x31, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0c, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2b, 0x0a, 0x08, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x73, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x49, 0x73, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x12, 0x16, 0x0a, 0x06, 0x49, 0x73, 0x57, 0x65, 0x61, 0x6b, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x49, 0x73, 0x57, 0x65, 0x61, 0x6b, 0x22, 0x4d, 0x0a, 0x09, 0x44,
+	0x6f, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x09,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
+	0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xa0, 0x04, 0x0a, 0x06, 0x53,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x12, 0x2c, 0x0a, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x09, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x06, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x73, 0x12, 0x1e, 0x0a, 0x06, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x06, 0x2e, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x55, 0x6e, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x2a, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x12, 0x31,
+	0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x52, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x4e, 0x0a, 0x16, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x16, 0x41, 0x6e, 0x6e, 0x6f, 0x74,
 	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x16, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x55, 0x6e, 0x69,
-	0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xd1, 0x01, 0x0a, 0x05, 0x55, 0x6e, 0x69, 0x6f,
-	0x6e, 0x12, 0x31, 0x0a, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x49, 0x73, 0x53, 0x79, 0x6e, 0x74, 0x68, 0x65, 0x74, 0x69, 0x63,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x49, 0x73, 0x53, 0x79, 0x6e, 0x74, 0x68, 0x65,
+	0x74, 0x69, 0x63, 0x12, 0x2b, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x24, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0f, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x0f, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x12, 0x2a, 0x0a, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x37, 0x0a,
+	0x0d, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x45, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x03, 0x45, 0x6e, 0x64, 0x22, 0x65, 0x0a, 0x0e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x45, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x45, 0x6e, 0x64,
+	0x12, 0x2b, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x55, 0x0a,
+	0x09, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x08, 0x45, 0x78,
+	0x74, 0x65, 0x6e, 0x64, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54,
+	0x79, 0x70, 0x65, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x08, 0x45, 0x78,
+	0x74, 0x65, 0x6e, 0x64, 0x65, 0x65, 0x12, 0x1c, 0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x05, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x22, 0x82, 0x03, 0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x31,
+	0x0a, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2a, 0x0a, 0x0c, 0x44, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x06, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0a, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x0a, 0x55, 0x6e, 0x69,
+	0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x0c, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
+	0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x4e, 0x0a,
+	0x16, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x16, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x0a,
+	0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x55,
+	0x6e, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xfe, 0x01, 0x0a, 0x05, 0x55, 0x6e,
+	0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x0c, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
+	0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x4e, 0x0a,
+	0x16, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x16, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x0a,
+	0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xf6, 0x02, 0x0a, 0x04, 0x45,
+	0x6e, 0x75, 0x6d, 0x12, 0x2c, 0x0a, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x0a, 0x45, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61,
+	0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x45, 0x6e, 0x75, 0x6d,
+	0x65, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x0a, 0x45, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x6e, 0x74,
+	0x73, 0x12, 0x2a, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x12, 0x24, 0x0a,
+	0x0d, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x4e, 0x0a, 0x16, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x16,
+	0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x82, 0x02, 0x0a, 0x09, 0x45, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x6e,
+	0x74, 0x12, 0x31, 0x0a, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
 	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72,
 	0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
@@ -3816,430 +4586,466 @@ var file_descriptor_proto_rawDesc = []byte{
 	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x41, 0x6e,
 	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
 	0x69, 0x6f, 0x6e, 0x52, 0x16, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41,
-	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xc9, 0x02, 0x0a, 0x04,
-	0x45, 0x6e, 0x75, 0x6d, 0x12, 0x2c, 0x0a, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x0a, 0x45, 0x6e, 0x75, 0x6d, 0x65, 0x72,
-	0x61, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x45, 0x6e, 0x75,
-	0x6d, 0x65, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x0a, 0x45, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x6e,
-	0x74, 0x73, 0x12, 0x2a, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x52,
-	0x61, 0x6e, 0x67, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x12, 0x24,
-	0x0a, 0x0d, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18,
-	0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x4e,
-	0x61, 0x6d, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x4e, 0x0a, 0x16, 0x41, 0x6e, 0x6e, 0x6f, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x16, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xd5, 0x01, 0x0a, 0x09, 0x45, 0x6e, 0x75, 0x6d,
-	0x65, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69,
-	0x62, 0x75, 0x74, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x52,
-	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x0c,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x52, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
-	0x4e, 0x0a, 0x16, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x16, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
-	0xf7, 0x02, 0x0a, 0x03, 0x41, 0x50, 0x49, 0x12, 0x2c, 0x0a, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x52, 0x65, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x04,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x07, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x41, 0x50, 0x49, 0x4d, 0x65, 0x74, 0x68, 0x6f,
-	0x64, 0x52, 0x07, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x07, 0x45, 0x78,
-	0x74, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x79,
-	0x70, 0x65, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x07, 0x45, 0x78, 0x74,
-	0x65, 0x6e, 0x64, 0x73, 0x12, 0x2a, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64,
-	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x64, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64,
-	0x12, 0x24, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65,
-	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0c, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x4e, 0x0a, 0x16, 0x41, 0x6e, 0x6e,
-	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x41, 0x6e, 0x6e, 0x6f,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x08, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa4, 0x03, 0x0a, 0x03, 0x41, 0x50, 0x49,
+	0x12, 0x2c, 0x0a, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x52, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1d,
+	0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x54,
+	0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a,
+	0x07, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a,
+	0x2e, 0x41, 0x50, 0x49, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x07, 0x4d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x07, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x53, 0x70, 0x65, 0x63, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x52, 0x07, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x2a, 0x0a,
+	0x08, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x08, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x52, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0d, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12,
+	0x31, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x12, 0x4e, 0x0a, 0x16, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x16, 0x41, 0x6e, 0x6e, 0x6f,
 	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x16, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xa3, 0x02, 0x0a, 0x09, 0x41, 0x50,
-	0x49, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x31, 0x0a, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x41, 0x74, 0x74,
-	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
-	0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24,
-	0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
-	0x54, 0x79, 0x70, 0x65, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x05, 0x49,
-	0x6e, 0x70, 0x75, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x53, 0x70, 0x65, 0x63, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x52, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x31, 0x0a, 0x0c,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x52, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
-	0x4e, 0x0a, 0x16, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x16, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
-	0xf7, 0x02, 0x0a, 0x03, 0x53, 0x44, 0x4b, 0x12, 0x2c, 0x0a, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x52, 0x65, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x04,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x07, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x53, 0x44, 0x4b, 0x4d, 0x65, 0x74, 0x68, 0x6f,
-	0x64, 0x52, 0x07, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x07, 0x45, 0x78,
-	0x74, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x79,
-	0x70, 0x65, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x07, 0x45, 0x78, 0x74,
-	0x65, 0x6e, 0x64, 0x73, 0x12, 0x2a, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64,
-	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x64, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64,
-	0x12, 0x24, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65,
-	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0c, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x4e, 0x0a, 0x16, 0x41, 0x6e, 0x6e,
-	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x41, 0x6e, 0x6e, 0x6f,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x16, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xc0, 0x02, 0x0a, 0x09, 0x53, 0x44,
-	0x4b, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x31, 0x0a, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x41, 0x74, 0x74,
-	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
-	0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25,
-	0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
-	0x53, 0x44, 0x4b, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05,
-	0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x53, 0x70, 0x65, 0x63,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1a, 0x0a,
-	0x08, 0x4e, 0x6f, 0x54, 0x68, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x08, 0x4e, 0x6f, 0x54, 0x68, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x31, 0x0a, 0x0c, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0c,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x4e, 0x0a, 0x16,
-	0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x41,
+	0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x96, 0x03, 0x0a, 0x09, 0x41, 0x50, 0x49, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x31, 0x0a,
+	0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x52, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x79, 0x70,
+	0x65, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x06, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x12, 0x31, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x4e, 0x0a, 0x16, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x16, 0x41,
 	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x16, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x7a, 0x0a, 0x0e,
-	0x53, 0x44, 0x4b, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x30,
-	0x0a, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x12, 0x2e, 0x53, 0x44, 0x4b, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x65, 0x66, 0x65,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x12, 0x22, 0x0a, 0x0c, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x22, 0xa4, 0x03, 0x0a, 0x03, 0x53, 0x44, 0x4b,
+	0x12, 0x2c, 0x0a, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x52, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1d,
+	0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x54,
+	0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a,
+	0x07, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a,
+	0x2e, 0x53, 0x44, 0x4b, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x07, 0x4d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x07, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x53, 0x70, 0x65, 0x63, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x52, 0x07, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x2a, 0x0a,
+	0x08, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x08, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x52, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0d, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12,
+	0x31, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x12, 0x4e, 0x0a, 0x16, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x16, 0x41, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0xed, 0x02, 0x0a, 0x09, 0x53, 0x44, 0x4b, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x31, 0x0a,
+	0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x65, 0x66, 0x65,
 	0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
 	0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69,
-	0x65, 0x72, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x22, 0x8b, 0x01, 0x0a, 0x11, 0x53, 0x44, 0x4b,
-	0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1c,
-	0x0a, 0x09, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x09, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x55, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07,
-	0x54, 0x79, 0x70, 0x65, 0x55, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x54,
-	0x79, 0x70, 0x65, 0x55, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
-	0x75, 0x74, 0x65, 0x55, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x41, 0x74,
-	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x55, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x6e,
-	0x70, 0x75, 0x74, 0x55, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x49, 0x6e,
-	0x70, 0x75, 0x74, 0x55, 0x49, 0x44, 0x22, 0xe3, 0x01, 0x0a, 0x0a, 0x41, 0x6e, 0x6e, 0x6f, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x53, 0x63, 0x6f, 0x70, 0x65,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x06, 0x53, 0x63, 0x6f, 0x70, 0x65,
-	0x73, 0x12, 0x22, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52,
-	0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x45, 0x0a, 0x16, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x16, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x91, 0x02, 0x0a,
-	0x08, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x09, 0x52, 0x65, 0x66,
-	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x52, 0x65,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x54,
-	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x79, 0x70, 0x65,
-	0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x1c, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06,
-	0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x4e, 0x0a,
-	0x16, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x53, 0x44, 0x4b, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x79,
+	0x70, 0x65, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x06, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x4e, 0x6f, 0x54, 0x68, 0x72, 0x6f, 0x77, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x4e, 0x6f, 0x54, 0x68, 0x72, 0x6f, 0x77, 0x73, 0x12,
+	0x31, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x12, 0x4e, 0x0a, 0x16, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x16, 0x41, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0xa7, 0x01, 0x0a, 0x0e, 0x53, 0x44, 0x4b, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x12, 0x30, 0x0a, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x53, 0x44, 0x4b, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x53, 0x70, 0x65,
+	0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2b, 0x0a, 0x08,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x8b, 0x01, 0x0a, 0x11, 0x53, 0x44,
+	0x4b, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x55, 0x49, 0x44, 0x12, 0x18, 0x0a,
+	0x07, 0x54, 0x79, 0x70, 0x65, 0x55, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x54, 0x79, 0x70, 0x65, 0x55, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x55, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x55, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x55, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x55, 0x49, 0x44, 0x22, 0x90, 0x02, 0x0a, 0x0a, 0x41, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x53, 0x63, 0x6f, 0x70,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x06, 0x53, 0x63, 0x6f, 0x70,
+	0x65, 0x73, 0x12, 0x22, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x45, 0x0a, 0x16, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x16, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f,
+	0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2b, 0x0a,
+	0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xbe, 0x02, 0x0a, 0x08, 0x43,
+	0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x53, 0x70,
+	0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a,
+	0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x4e, 0x0a, 0x16, 0x41,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x41, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x16, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x31, 0x0a, 0x0c, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x52, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2b,
+	0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x92, 0x01, 0x0a, 0x15,
 	0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x16, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x31, 0x0a,
-	0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x52, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x22, 0x65, 0x0a, 0x15, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x0a, 0x41, 0x6e, 0x6e,
-	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
-	0x54, 0x79, 0x70, 0x65, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0a, 0x41,
-	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x05, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xf9, 0x05, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x12, 0x20, 0x0a, 0x04, 0x42, 0x6f, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0a, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x6f, 0x6f, 0x6c, 0x48, 0x00, 0x52, 0x04, 0x42,
-	0x6f, 0x6f, 0x6c, 0x12, 0x20, 0x0a, 0x04, 0x54, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0a, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x65, 0x78, 0x74, 0x48, 0x00, 0x52,
-	0x04, 0x54, 0x65, 0x78, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x44, 0x61, 0x74, 0x61, 0x48,
-	0x00, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x04, 0x49, 0x6e, 0x74, 0x38, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x49, 0x6e, 0x74,
-	0x38, 0x48, 0x00, 0x52, 0x04, 0x49, 0x6e, 0x74, 0x38, 0x12, 0x23, 0x0a, 0x05, 0x49, 0x6e, 0x74,
-	0x31, 0x36, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x49, 0x6e, 0x74, 0x31, 0x36, 0x48, 0x00, 0x52, 0x05, 0x49, 0x6e, 0x74, 0x31, 0x36, 0x12, 0x23,
-	0x0a, 0x05, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x48, 0x00, 0x52, 0x05, 0x49, 0x6e,
-	0x74, 0x33, 0x32, 0x12, 0x23, 0x0a, 0x05, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x48,
-	0x00, 0x52, 0x05, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x23, 0x0a, 0x05, 0x55, 0x49, 0x6e, 0x74,
-	0x38, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x55,
-	0x49, 0x6e, 0x74, 0x38, 0x48, 0x00, 0x52, 0x05, 0x55, 0x49, 0x6e, 0x74, 0x38, 0x12, 0x26, 0x0a,
-	0x06, 0x55, 0x49, 0x6e, 0x74, 0x31, 0x36, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x55, 0x49, 0x6e, 0x74, 0x31, 0x36, 0x48, 0x00, 0x52, 0x06, 0x55,
-	0x49, 0x6e, 0x74, 0x31, 0x36, 0x12, 0x26, 0x0a, 0x06, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x55, 0x49, 0x6e,
-	0x74, 0x33, 0x32, 0x48, 0x00, 0x52, 0x06, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x26, 0x0a,
-	0x06, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x48, 0x00, 0x52, 0x06, 0x55,
-	0x49, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x29, 0x0a, 0x07, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x33, 0x32,
-	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x46, 0x6c,
-	0x6f, 0x61, 0x74, 0x33, 0x32, 0x48, 0x00, 0x52, 0x07, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x33, 0x32,
-	0x12, 0x29, 0x0a, 0x07, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x36, 0x34, 0x18, 0x0e, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0d, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x36, 0x34,
-	0x48, 0x00, 0x52, 0x07, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x36, 0x34, 0x12, 0x20, 0x0a, 0x04, 0x4c,
-	0x69, 0x73, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x00, 0x52, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x26, 0x0a,
-	0x06, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x48, 0x00, 0x52, 0x06, 0x53,
-	0x74, 0x72, 0x75, 0x63, 0x74, 0x12, 0x33, 0x0a, 0x09, 0x45, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61,
-	0x6e, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69,
-	0x62, 0x75, 0x74, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x48, 0x00, 0x52,
-	0x09, 0x45, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x0a, 0x49, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
-	0x48, 0x00, 0x52, 0x0a, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x23,
-	0x0a, 0x05, 0x55, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x55, 0x6e, 0x61, 0x72, 0x79, 0x48, 0x00, 0x52, 0x05, 0x55, 0x6e,
-	0x61, 0x72, 0x79, 0x12, 0x26, 0x0a, 0x06, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x15, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x69, 0x6e, 0x61, 0x72,
-	0x79, 0x48, 0x00, 0x52, 0x06, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x42, 0x06, 0x0a, 0x04, 0x4b,
-	0x69, 0x6e, 0x64, 0x22, 0x39, 0x0a, 0x09, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x6f, 0x6f, 0x6c,
-	0x12, 0x14, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x0a, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x79, 0x70, 0x65,
+	0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0a, 0x41, 0x6e, 0x6e, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0xc5, 0x06, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x42, 0x6f,
+	0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x42, 0x6f, 0x6f, 0x6c, 0x48, 0x00, 0x52, 0x04, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x20, 0x0a, 0x04,
+	0x54, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x54, 0x65, 0x78, 0x74, 0x48, 0x00, 0x52, 0x04, 0x54, 0x65, 0x78, 0x74, 0x12, 0x20,
+	0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x20, 0x0a, 0x04, 0x49, 0x6e, 0x74, 0x38, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a,
+	0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x49, 0x6e, 0x74, 0x38, 0x48, 0x00, 0x52, 0x04, 0x49, 0x6e,
+	0x74, 0x38, 0x12, 0x23, 0x0a, 0x05, 0x49, 0x6e, 0x74, 0x31, 0x36, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x49, 0x6e, 0x74, 0x31, 0x36, 0x48, 0x00,
+	0x52, 0x05, 0x49, 0x6e, 0x74, 0x31, 0x36, 0x12, 0x23, 0x0a, 0x05, 0x49, 0x6e, 0x74, 0x33, 0x32,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x49, 0x6e,
+	0x74, 0x33, 0x32, 0x48, 0x00, 0x52, 0x05, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x23, 0x0a, 0x05,
+	0x49, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x48, 0x00, 0x52, 0x05, 0x49, 0x6e, 0x74, 0x36,
+	0x34, 0x12, 0x23, 0x0a, 0x05, 0x55, 0x49, 0x6e, 0x74, 0x38, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x55, 0x49, 0x6e, 0x74, 0x38, 0x48, 0x00, 0x52,
+	0x05, 0x55, 0x49, 0x6e, 0x74, 0x38, 0x12, 0x26, 0x0a, 0x06, 0x55, 0x49, 0x6e, 0x74, 0x31, 0x36,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x55, 0x49,
+	0x6e, 0x74, 0x31, 0x36, 0x48, 0x00, 0x52, 0x06, 0x55, 0x49, 0x6e, 0x74, 0x31, 0x36, 0x12, 0x26,
+	0x0a, 0x06, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x48, 0x00, 0x52, 0x06,
+	0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x26, 0x0a, 0x06, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x55, 0x49,
+	0x6e, 0x74, 0x36, 0x34, 0x48, 0x00, 0x52, 0x06, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x29,
+	0x0a, 0x07, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x33, 0x32, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x33, 0x32, 0x48, 0x00,
+	0x52, 0x07, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x33, 0x32, 0x12, 0x29, 0x0a, 0x07, 0x46, 0x6c, 0x6f,
+	0x61, 0x74, 0x36, 0x34, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x36, 0x34, 0x48, 0x00, 0x52, 0x07, 0x46, 0x6c, 0x6f,
+	0x61, 0x74, 0x36, 0x34, 0x12, 0x20, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x03, 0x4d, 0x61, 0x70, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4d, 0x61, 0x70, 0x48, 0x00,
+	0x52, 0x03, 0x4d, 0x61, 0x70, 0x12, 0x26, 0x0a, 0x06, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x48, 0x00, 0x52, 0x06, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x12, 0x33, 0x0a,
+	0x09, 0x45, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x6e, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x48, 0x00, 0x52, 0x09, 0x45, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61,
+	0x6e, 0x74, 0x12, 0x32, 0x0a, 0x0a, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0a, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x05, 0x55, 0x6e, 0x61, 0x72, 0x79, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x55, 0x6e, 0x61,
+	0x72, 0x79, 0x48, 0x00, 0x52, 0x05, 0x55, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x26, 0x0a, 0x06, 0x42,
+	0x69, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x48, 0x00, 0x52, 0x06, 0x42, 0x69, 0x6e,
+	0x61, 0x72, 0x79, 0x12, 0x2b, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x06, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x22, 0x39, 0x0a, 0x09, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x22, 0x39, 0x0a, 0x09, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x65, 0x78, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x39,
-	0x0a, 0x09, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75,
+	0x0a, 0x09, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75,
 	0x65, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x39, 0x0a, 0x09, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x65, 0x49, 0x6e, 0x74, 0x38, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06,
 	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x22, 0x39, 0x0a, 0x09, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x49, 0x6e, 0x74,
-	0x38, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22,
-	0x3a, 0x0a, 0x0a, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x49, 0x6e, 0x74, 0x31, 0x36, 0x12, 0x14, 0x0a,
-	0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x3a, 0x0a, 0x0a, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x3a, 0x0a, 0x0a, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x49, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x22, 0x3a, 0x0a, 0x0a, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x55, 0x49, 0x6e, 0x74,
-	0x38, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22,
-	0x3b, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x55, 0x49, 0x6e, 0x74, 0x31, 0x36, 0x12, 0x14,
-	0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x56,
+	0x75, 0x72, 0x63, 0x65, 0x22, 0x3a, 0x0a, 0x0a, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x49, 0x6e, 0x74,
+	0x31, 0x36, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x22, 0x3a, 0x0a, 0x0a, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x14,
+	0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x56,
 	0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x3b, 0x0a, 0x0b,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x14, 0x0a, 0x05, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x3b, 0x0a, 0x0b, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16,
-	0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x3c, 0x0a, 0x0c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x46,
-	0x6c, 0x6f, 0x61, 0x74, 0x33, 0x32, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x3a, 0x0a, 0x0a,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x3a, 0x0a, 0x0a, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x55, 0x49, 0x6e, 0x74, 0x38, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06,
 	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x22, 0x3c, 0x0a, 0x0c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x46, 0x6c, 0x6f,
-	0x61, 0x74, 0x36, 0x34, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x22, 0x8f, 0x01, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x49, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x04,
-	0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x48, 0x00, 0x52, 0x04, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x33, 0x0a, 0x09, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
-	0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x48, 0x00, 0x52, 0x09, 0x41, 0x74,
-	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x22, 0x2f, 0x0a, 0x09, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x22, 0x0a, 0x08, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x45, 0x6c, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x38, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x74,
-	0x72, 0x75, 0x63, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x74, 0x72, 0x75,
-	0x63, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22,
-	0x44, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x59, 0x0a, 0x0a, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x55, 0x6e,
-	0x61, 0x72, 0x79, 0x12, 0x2d, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x55, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x06, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x22, 0x77, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12,
-	0x2e, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x10, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69,
-	0x6e, 0x61, 0x72, 0x79, 0x52, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x1a, 0x0a, 0x04, 0x4c, 0x65, 0x66, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x4c, 0x65, 0x66, 0x74, 0x12, 0x1c, 0x0a, 0x05, 0x52,
-	0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x05, 0x52, 0x69, 0x67, 0x68, 0x74, 0x22, 0x47, 0x0a, 0x0d, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x4d, 0x6f,
-	0x64, 0x75, 0x6c, 0x65, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x4d,
-	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x55, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x79, 0x70, 0x65,
-	0x55, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x54, 0x79, 0x70, 0x65, 0x55,
-	0x49, 0x44, 0x22, 0x7d, 0x0a, 0x0d, 0x54, 0x79, 0x70, 0x65, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66,
-	0x69, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x07, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x48, 0x00, 0x52, 0x07, 0x46, 0x6f, 0x72, 0x77, 0x61,
-	0x72, 0x64, 0x12, 0x30, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x52,
-	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x48, 0x00, 0x52, 0x08, 0x52, 0x65, 0x73, 0x6f,
-	0x6c, 0x76, 0x65, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x22, 0x79, 0x0a, 0x10, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x09, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x67, 0x6c,
-	0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x4d, 0x69, 0x63, 0x72, 0x6f,
-	0x67, 0x6c, 0x6f, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x48, 0x00, 0x52, 0x09, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x67, 0x6c, 0x6f,
-	0x74, 0x12, 0x1c, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x42,
-	0x0b, 0x0a, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x58, 0x0a, 0x19,
-	0x4d, 0x69, 0x63, 0x72, 0x6f, 0x67, 0x6c, 0x6f, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
-	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x51, 0x75, 0x61,
-	0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x51, 0x75,
-	0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65,
-	0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x71, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76,
-	0x65, 0x64, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x2c, 0x0a, 0x09, 0x52,
-	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
-	0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09,
-	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x0a, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
-	0x54, 0x79, 0x70, 0x65, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0a, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0x70, 0x0a, 0x12, 0x41, 0x74, 0x74,
-	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x09, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x55, 0x49, 0x44, 0x12, 0x18, 0x0a,
-	0x07, 0x54, 0x79, 0x70, 0x65, 0x55, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
-	0x54, 0x79, 0x70, 0x65, 0x55, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x41, 0x74, 0x74, 0x72, 0x69,
-	0x62, 0x75, 0x74, 0x65, 0x55, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x41,
-	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x55, 0x49, 0x44, 0x22, 0x24, 0x0a, 0x0c, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x4c,
-	0x69, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x4c, 0x69, 0x6e, 0x65,
-	0x73, 0x22, 0x4e, 0x0a, 0x08, 0x54, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x2e, 0x0a, 0x0a, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x53, 0x70, 0x65, 0x63,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0a, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
-	0x73, 0x2a, 0x85, 0x03, 0x0a, 0x0f, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x5a, 0x65, 0x72, 0x6f, 0x10, 0x00, 0x12, 0x19,
-	0x0a, 0x15, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70,
-	0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x6e, 0x6e,
-	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x55, 0x6e, 0x69, 0x6f,
-	0x6e, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x10, 0x03, 0x12, 0x18,
-	0x0a, 0x14, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70,
-	0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x10, 0x04, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x6e, 0x6e, 0x6f,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x65,
-	0x72, 0x61, 0x6e, 0x74, 0x10, 0x05, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x10, 0x06, 0x12,
-	0x16, 0x0a, 0x12, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f,
-	0x70, 0x65, 0x41, 0x50, 0x49, 0x10, 0x07, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x6e, 0x6e, 0x6f, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x41, 0x50, 0x49, 0x4d, 0x65, 0x74,
-	0x68, 0x6f, 0x64, 0x10, 0x08, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x53, 0x44, 0x4b, 0x10, 0x09, 0x12, 0x1c, 0x0a,
-	0x18, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65,
-	0x53, 0x44, 0x4b, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x10, 0x0a, 0x12, 0x18, 0x0a, 0x14, 0x41,
-	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x43, 0x6f,
-	0x6e, 0x73, 0x74, 0x10, 0x0b, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x10, 0x0c,
-	0x12, 0x17, 0x0a, 0x13, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63,
-	0x6f, 0x70, 0x65, 0x53, 0x74, 0x61, 0x72, 0x10, 0x0d, 0x2a, 0x77, 0x0a, 0x0e, 0x4f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x12, 0x4f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x61, 0x72, 0x79, 0x5a, 0x65, 0x72,
-	0x6f, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x55, 0x6e, 0x61, 0x72, 0x79, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x10, 0x01, 0x12,
-	0x1a, 0x0a, 0x16, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x61, 0x72,
-	0x79, 0x4e, 0x65, 0x67, 0x61, 0x74, 0x69, 0x76, 0x65, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x4f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x61, 0x72, 0x79, 0x4e, 0x6f, 0x74,
-	0x10, 0x03, 0x2a, 0xa9, 0x04, 0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x13, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x5a, 0x65, 0x72, 0x6f, 0x10, 0x00, 0x12,
-	0x15, 0x0a, 0x11, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x6e, 0x61,
-	0x72, 0x79, 0x4f, 0x72, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x41, 0x6e, 0x64, 0x10, 0x02, 0x12, 0x18,
-	0x0a, 0x14, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x6e, 0x61, 0x72,
-	0x79, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x4f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x4e, 0x6f, 0x74, 0x45, 0x71,
-	0x75, 0x61, 0x6c, 0x10, 0x04, 0x12, 0x1b, 0x0a, 0x17, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x4c, 0x65, 0x73, 0x73, 0x54, 0x68, 0x61, 0x6e,
-	0x10, 0x05, 0x12, 0x20, 0x0a, 0x1c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
-	0x69, 0x6e, 0x61, 0x72, 0x79, 0x4c, 0x65, 0x73, 0x73, 0x54, 0x68, 0x61, 0x6e, 0x45, 0x71, 0x75,
-	0x61, 0x6c, 0x10, 0x06, 0x12, 0x1e, 0x0a, 0x1a, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x47, 0x72, 0x65, 0x61, 0x74, 0x65, 0x72, 0x54, 0x68,
-	0x61, 0x6e, 0x10, 0x07, 0x12, 0x23, 0x0a, 0x1f, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x47, 0x72, 0x65, 0x61, 0x74, 0x65, 0x72, 0x54, 0x68,
-	0x61, 0x6e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x10, 0x08, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x41, 0x64, 0x64, 0x10,
-	0x09, 0x12, 0x1b, 0x0a, 0x17, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69,
-	0x6e, 0x61, 0x72, 0x79, 0x53, 0x75, 0x62, 0x74, 0x72, 0x61, 0x63, 0x74, 0x10, 0x0a, 0x12, 0x18,
-	0x0a, 0x14, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x6e, 0x61, 0x72,
-	0x79, 0x42, 0x69, 0x6e, 0x4f, 0x72, 0x10, 0x0b, 0x12, 0x19, 0x0a, 0x15, 0x4f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x42, 0x69, 0x6e, 0x41, 0x6e,
-	0x64, 0x10, 0x0c, 0x12, 0x19, 0x0a, 0x15, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x42, 0x69, 0x74, 0x58, 0x6f, 0x72, 0x10, 0x0d, 0x12, 0x1c,
-	0x0a, 0x18, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x6e, 0x61, 0x72,
-	0x79, 0x53, 0x68, 0x69, 0x66, 0x74, 0x4c, 0x65, 0x66, 0x74, 0x10, 0x0e, 0x12, 0x1d, 0x0a, 0x19,
-	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x53,
-	0x68, 0x69, 0x66, 0x74, 0x52, 0x69, 0x67, 0x68, 0x74, 0x10, 0x0f, 0x12, 0x1b, 0x0a, 0x17, 0x4f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x4d, 0x75,
-	0x6c, 0x74, 0x69, 0x70, 0x6c, 0x79, 0x10, 0x10, 0x12, 0x19, 0x0a, 0x15, 0x4f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x44, 0x69, 0x76, 0x69, 0x64,
-	0x65, 0x10, 0x11, 0x12, 0x19, 0x0a, 0x15, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x6f, 0x10, 0x12, 0x42, 0x30,
-	0x5a, 0x2e, 0x67, 0x6f, 0x70, 0x6b, 0x67, 0x2e, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x67, 0x6c, 0x6f,
-	0x74, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x72, 0x2e, 0x67,
-	0x6f, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x75, 0x72, 0x63, 0x65, 0x22, 0x3b, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x55, 0x49, 0x6e,
+	0x74, 0x31, 0x36, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x22, 0x3b, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32,
+	0x12, 0x14, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x3b,
+	0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x14, 0x0a,
+	0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x3c, 0x0a, 0x0c, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x33, 0x32, 0x12, 0x14, 0x0a, 0x05, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x3c, 0x0a, 0x0c, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x36, 0x34, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x8f, 0x01, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x12, 0x24, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x48,
+	0x00, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x33, 0x0a, 0x09, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x48,
+	0x00, 0x52, 0x09, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x42, 0x0b, 0x0a, 0x09,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x2f, 0x0a, 0x09, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x08, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x08, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x34, 0x0a, 0x08, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x4d, 0x61, 0x70, 0x12, 0x28, 0x0a, 0x07, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4d,
+	0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x22, 0x47, 0x0a, 0x0d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x18, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06,
+	0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x05, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x38, 0x0a, 0x0b, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x22, 0x44, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x05, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x59, 0x0a, 0x0a, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x55, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x2d, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x09, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0x77, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x69, 0x6e,
+	0x61, 0x72, 0x79, 0x12, 0x2e, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x04, 0x4c, 0x65, 0x66, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x06, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x4c, 0x65, 0x66, 0x74, 0x12,
+	0x1c, 0x0a, 0x05, 0x52, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06,
+	0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x52, 0x69, 0x67, 0x68, 0x74, 0x22, 0x47, 0x0a,
+	0x0d, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x55, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07,
+	0x54, 0x79, 0x70, 0x65, 0x55, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x54,
+	0x79, 0x70, 0x65, 0x55, 0x49, 0x44, 0x22, 0xaa, 0x01, 0x0a, 0x0d, 0x54, 0x79, 0x70, 0x65, 0x53,
+	0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x07, 0x46, 0x6f, 0x72, 0x77,
+	0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x46, 0x6f, 0x72, 0x77,
+	0x61, 0x72, 0x64, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x48, 0x00, 0x52, 0x07,
+	0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x12, 0x30, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x52, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x64, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x48, 0x00, 0x52,
+	0x08, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x08, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0b, 0x0a, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x22, 0x79, 0x0a, 0x10, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x09, 0x4d, 0x69, 0x63, 0x72, 0x6f,
+	0x67, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x4d, 0x69, 0x63,
+	0x72, 0x6f, 0x67, 0x6c, 0x6f, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x48, 0x00, 0x52, 0x09, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x67,
+	0x6c, 0x6f, 0x74, 0x12, 0x1c, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x42, 0x0b, 0x0a, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x58,
+	0x0a, 0x19, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x67, 0x6c, 0x6f, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61,
+	0x72, 0x64, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x51,
+	0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x51, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x04, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x71, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x64, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x2c, 0x0a,
+	0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x0a, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52,
+	0x0a, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0x70, 0x0a, 0x12, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x55, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x55, 0x49, 0x44, 0x12,
+	0x18, 0x0a, 0x07, 0x54, 0x79, 0x70, 0x65, 0x55, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x54, 0x79, 0x70, 0x65, 0x55, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x55, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0c, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x55, 0x49, 0x44, 0x22, 0x24, 0x0a,
+	0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x14, 0x0a,
+	0x05, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x4c, 0x69,
+	0x6e, 0x65, 0x73, 0x22, 0x4e, 0x0a, 0x08, 0x54, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x0a, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x53, 0x70,
+	0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0a, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x22, 0x9f, 0x01, 0x0a, 0x0d, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x05, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x05, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x54, 0x6f, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x46, 0x69,
+	0x6c, 0x65, 0x73, 0x54, 0x6f, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x0f, 0x43,
+	0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x72, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x51, 0x0a, 0x0e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x29, 0x0a,
+	0x05, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x50,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x46, 0x69, 0x6c,
+	0x65, 0x52, 0x05, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x42, 0x0a, 0x12, 0x50, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x54, 0x0a, 0x0e,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x4c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x4c, 0x69,
+	0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x2a, 0x85, 0x03, 0x0a, 0x0f, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x5a, 0x65, 0x72, 0x6f, 0x10, 0x00, 0x12,
+	0x19, 0x0a, 0x15, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f,
+	0x70, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x55, 0x6e, 0x69,
+	0x6f, 0x6e, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x10, 0x03, 0x12,
+	0x18, 0x0a, 0x14, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f,
+	0x70, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x10, 0x04, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x6e, 0x6e,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x45, 0x6e, 0x75, 0x6d,
+	0x65, 0x72, 0x61, 0x6e, 0x74, 0x10, 0x05, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x6e, 0x6e, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x10, 0x06,
+	0x12, 0x16, 0x0a, 0x12, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63,
+	0x6f, 0x70, 0x65, 0x41, 0x50, 0x49, 0x10, 0x07, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x41, 0x50, 0x49, 0x4d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x10, 0x08, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x53, 0x44, 0x4b, 0x10, 0x09, 0x12, 0x1c,
+	0x0a, 0x18, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70,
+	0x65, 0x53, 0x44, 0x4b, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x10, 0x0a, 0x12, 0x18, 0x0a, 0x14,
+	0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x43,
+	0x6f, 0x6e, 0x73, 0x74, 0x10, 0x0b, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x10,
+	0x0c, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x63, 0x6f, 0x70, 0x65, 0x53, 0x74, 0x61, 0x72, 0x10, 0x0d, 0x2a, 0x77, 0x0a, 0x0e, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x12,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x61, 0x72, 0x79, 0x5a, 0x65,
+	0x72, 0x6f, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x55, 0x6e, 0x61, 0x72, 0x79, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x10, 0x01,
+	0x12, 0x1a, 0x0a, 0x16, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x61,
+	0x72, 0x79, 0x4e, 0x65, 0x67, 0x61, 0x74, 0x69, 0x76, 0x65, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x61, 0x72, 0x79, 0x4e, 0x6f,
+	0x74, 0x10, 0x03, 0x2a, 0xa9, 0x04, 0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x13, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x5a, 0x65, 0x72, 0x6f, 0x10, 0x00,
+	0x12, 0x15, 0x0a, 0x11, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x6e,
+	0x61, 0x72, 0x79, 0x4f, 0x72, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x41, 0x6e, 0x64, 0x10, 0x02, 0x12,
+	0x18, 0x0a, 0x14, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x6e, 0x61,
+	0x72, 0x79, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x4e, 0x6f, 0x74, 0x45,
+	0x71, 0x75, 0x61, 0x6c, 0x10, 0x04, 0x12, 0x1b, 0x0a, 0x17, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x4c, 0x65, 0x73, 0x73, 0x54, 0x68, 0x61,
+	0x6e, 0x10, 0x05, 0x12, 0x20, 0x0a, 0x1c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x4c, 0x65, 0x73, 0x73, 0x54, 0x68, 0x61, 0x6e, 0x45, 0x71,
+	0x75, 0x61, 0x6c, 0x10, 0x06, 0x12, 0x1e, 0x0a, 0x1a, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x47, 0x72, 0x65, 0x61, 0x74, 0x65, 0x72, 0x54,
+	0x68, 0x61, 0x6e, 0x10, 0x07, 0x12, 0x23, 0x0a, 0x1f, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x47, 0x72, 0x65, 0x61, 0x74, 0x65, 0x72, 0x54,
+	0x68, 0x61, 0x6e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x10, 0x08, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x41, 0x64, 0x64,
+	0x10, 0x09, 0x12, 0x1b, 0x0a, 0x17, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x69, 0x6e, 0x61, 0x72, 0x79, 0x53, 0x75, 0x62, 0x74, 0x72, 0x61, 0x63, 0x74, 0x10, 0x0a, 0x12,
+	0x18, 0x0a, 0x14, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x6e, 0x61,
+	0x72, 0x79, 0x42, 0x69, 0x6e, 0x4f, 0x72, 0x10, 0x0b, 0x12, 0x19, 0x0a, 0x15, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x42, 0x69, 0x6e, 0x41,
+	0x6e, 0x64, 0x10, 0x0c, 0x12, 0x19, 0x0a, 0x15, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x42, 0x69, 0x74, 0x58, 0x6f, 0x72, 0x10, 0x0d, 0x12,
+	0x1c, 0x0a, 0x18, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x6e, 0x61,
+	0x72, 0x79, 0x53, 0x68, 0x69, 0x66, 0x74, 0x4c, 0x65, 0x66, 0x74, 0x10, 0x0e, 0x12, 0x1d, 0x0a,
+	0x19, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79,
+	0x53, 0x68, 0x69, 0x66, 0x74, 0x52, 0x69, 0x67, 0x68, 0x74, 0x10, 0x0f, 0x12, 0x1b, 0x0a, 0x17,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x79, 0x10, 0x10, 0x12, 0x19, 0x0a, 0x15, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x44, 0x69, 0x76, 0x69,
+	0x64, 0x65, 0x10, 0x11, 0x12, 0x19, 0x0a, 0x15, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x6f, 0x10, 0x12, 0x42,
+	0x2b, 0x5a, 0x29, 0x67, 0x6f, 0x70, 0x6b, 0x67, 0x2e, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x67, 0x6c,
+	0x6f, 0x74, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6d, 0x67, 0x6c, 0x6f, 0x74, 0x63, 0x2f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4255,7 +5061,7 @@ func file_descriptor_proto_rawDescGZIP() []byte {
 }
 
 var file_descriptor_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_descriptor_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
+var file_descriptor_proto_msgTypes = make([]protoimpl.MessageInfo, 55)
 var file_descriptor_proto_goTypes = []any{
 	(AnnotationScope)(0),              // 0: AnnotationScope
 	(OperationUnary)(0),               // 1: OperationUnary
@@ -4266,162 +5072,198 @@ var file_descriptor_proto_goTypes = []any{
 	(*DotImport)(nil),                 // 6: DotImport
 	(*Struct)(nil),                    // 7: Struct
 	(*ReservedRange)(nil),             // 8: ReservedRange
-	(*Field)(nil),                     // 9: Field
-	(*Union)(nil),                     // 10: Union
-	(*Enum)(nil),                      // 11: Enum
-	(*Enumerant)(nil),                 // 12: Enumerant
-	(*API)(nil),                       // 13: API
-	(*APIMethod)(nil),                 // 14: APIMethod
-	(*SDK)(nil),                       // 15: SDK
-	(*SDKMethod)(nil),                 // 16: SDKMethod
-	(*SDKMethodInput)(nil),            // 17: SDKMethodInput
-	(*SDKInputReference)(nil),         // 18: SDKInputReference
-	(*Annotation)(nil),                // 19: Annotation
-	(*Constant)(nil),                  // 20: Constant
-	(*AnnotationApplication)(nil),     // 21: AnnotationApplication
-	(*Value)(nil),                     // 22: Value
-	(*ValueBool)(nil),                 // 23: ValueBool
-	(*ValueText)(nil),                 // 24: ValueText
-	(*ValueData)(nil),                 // 25: ValueData
-	(*ValueInt8)(nil),                 // 26: ValueInt8
-	(*ValueInt16)(nil),                // 27: ValueInt16
-	(*ValueInt32)(nil),                // 28: ValueInt32
-	(*ValueInt64)(nil),                // 29: ValueInt64
-	(*ValueUInt8)(nil),                // 30: ValueUInt8
-	(*ValueUInt16)(nil),               // 31: ValueUInt16
-	(*ValueUInt32)(nil),               // 32: ValueUInt32
-	(*ValueUInt64)(nil),               // 33: ValueUInt64
-	(*ValueFloat32)(nil),              // 34: ValueFloat32
-	(*ValueFloat64)(nil),              // 35: ValueFloat64
-	(*ValueIdentifier)(nil),           // 36: ValueIdentifier
-	(*ValueList)(nil),                 // 37: ValueList
-	(*ValueStruct)(nil),               // 38: ValueStruct
-	(*ValueStructField)(nil),          // 39: ValueStructField
-	(*ValueUnary)(nil),                // 40: ValueUnary
-	(*ValueBinary)(nil),               // 41: ValueBinary
-	(*TypeReference)(nil),             // 42: TypeReference
-	(*TypeSpecifier)(nil),             // 43: TypeSpecifier
-	(*ForwardReference)(nil),          // 44: ForwardReference
-	(*MicroglotForwardReference)(nil), // 45: MicroglotForwardReference
-	(*ResolvedReference)(nil),         // 46: ResolvedReference
-	(*AttributeReference)(nil),        // 47: AttributeReference
-	(*CommentBlock)(nil),              // 48: CommentBlock
-	(*TypeName)(nil),                  // 49: TypeName
+	(*ExtensionRange)(nil),            // 9: ExtensionRange
+	(*Extension)(nil),                 // 10: Extension
+	(*Field)(nil),                     // 11: Field
+	(*Union)(nil),                     // 12: Union
+	(*Enum)(nil),                      // 13: Enum
+	(*Enumerant)(nil),                 // 14: Enumerant
+	(*API)(nil),                       // 15: API
+	(*APIMethod)(nil),                 // 16: APIMethod
+	(*SDK)(nil),                       // 17: SDK
+	(*SDKMethod)(nil),                 // 18: SDKMethod
+	(*SDKMethodInput)(nil),            // 19: SDKMethodInput
+	(*SDKInputReference)(nil),         // 20: SDKInputReference
+	(*Annotation)(nil),                // 21: Annotation
+	(*Constant)(nil),                  // 22: Constant
+	(*AnnotationApplication)(nil),     // 23: AnnotationApplication
+	(*Value)(nil),                     // 24: Value
+	(*ValueBool)(nil),                 // 25: ValueBool
+	(*ValueText)(nil),                 // 26: ValueText
+	(*ValueData)(nil),                 // 27: ValueData
+	(*ValueInt8)(nil),                 // 28: ValueInt8
+	(*ValueInt16)(nil),                // 29: ValueInt16
+	(*ValueInt32)(nil),                // 30: ValueInt32
+	(*ValueInt64)(nil),                // 31: ValueInt64
+	(*ValueUInt8)(nil),                // 32: ValueUInt8
+	(*ValueUInt16)(nil),               // 33: ValueUInt16
+	(*ValueUInt32)(nil),               // 34: ValueUInt32
+	(*ValueUInt64)(nil),               // 35: ValueUInt64
+	(*ValueFloat32)(nil),              // 36: ValueFloat32
+	(*ValueFloat64)(nil),              // 37: ValueFloat64
+	(*ValueIdentifier)(nil),           // 38: ValueIdentifier
+	(*ValueList)(nil),                 // 39: ValueList
+	(*ValueMap)(nil),                  // 40: ValueMap
+	(*ValueMapEntry)(nil),             // 41: ValueMapEntry
+	(*ValueStruct)(nil),               // 42: ValueStruct
+	(*ValueStructField)(nil),          // 43: ValueStructField
+	(*ValueUnary)(nil),                // 44: ValueUnary
+	(*ValueBinary)(nil),               // 45: ValueBinary
+	(*TypeReference)(nil),             // 46: TypeReference
+	(*TypeSpecifier)(nil),             // 47: TypeSpecifier
+	(*ForwardReference)(nil),          // 48: ForwardReference
+	(*MicroglotForwardReference)(nil), // 49: MicroglotForwardReference
+	(*ResolvedReference)(nil),         // 50: ResolvedReference
+	(*AttributeReference)(nil),        // 51: AttributeReference
+	(*CommentBlock)(nil),              // 52: CommentBlock
+	(*TypeName)(nil),                  // 53: TypeName
+	(*PluginRequest)(nil),             // 54: PluginRequest
+	(*PluginResponse)(nil),            // 55: PluginResponse
+	(*PluginResponseFile)(nil),        // 56: PluginResponseFile
+	(*SourceLocation)(nil),            // 57: SourceLocation
 }
 var file_descriptor_proto_depIdxs = []int32{
 	4,   // 0: Image.Modules:type_name -> Module
-	21,  // 1: Module.AnnotationApplications:type_name -> AnnotationApplication
+	23,  // 1: Module.AnnotationApplications:type_name -> AnnotationApplication
 	5,   // 2: Module.Imports:type_name -> Import
 	7,   // 3: Module.Structs:type_name -> Struct
-	11,  // 4: Module.Enums:type_name -> Enum
-	13,  // 5: Module.APIs:type_name -> API
-	15,  // 6: Module.SDKs:type_name -> SDK
-	20,  // 7: Module.Constants:type_name -> Constant
-	19,  // 8: Module.Annotations:type_name -> Annotation
+	13,  // 4: Module.Enums:type_name -> Enum
+	15,  // 5: Module.APIs:type_name -> API
+	17,  // 6: Module.SDKs:type_name -> SDK
+	22,  // 7: Module.Constants:type_name -> Constant
+	21,  // 8: Module.Annotations:type_name -> Annotation
 	6,   // 9: Module.DotImports:type_name -> DotImport
-	48,  // 10: Import.CommentBlock:type_name -> CommentBlock
-	42,  // 11: DotImport.Reference:type_name -> TypeReference
-	42,  // 12: Struct.Reference:type_name -> TypeReference
-	49,  // 13: Struct.Name:type_name -> TypeName
-	9,   // 14: Struct.Fields:type_name -> Field
-	10,  // 15: Struct.Unions:type_name -> Union
-	8,   // 16: Struct.Reserved:type_name -> ReservedRange
-	48,  // 17: Struct.CommentBlock:type_name -> CommentBlock
-	21,  // 18: Struct.AnnotationApplications:type_name -> AnnotationApplication
-	47,  // 19: Field.Reference:type_name -> AttributeReference
-	43,  // 20: Field.Type:type_name -> TypeSpecifier
-	22,  // 21: Field.DefaultValue:type_name -> Value
-	48,  // 22: Field.CommentBlock:type_name -> CommentBlock
-	21,  // 23: Field.AnnotationApplications:type_name -> AnnotationApplication
-	47,  // 24: Union.Reference:type_name -> AttributeReference
-	48,  // 25: Union.CommentBlock:type_name -> CommentBlock
-	21,  // 26: Union.AnnotationApplications:type_name -> AnnotationApplication
-	42,  // 27: Enum.Reference:type_name -> TypeReference
-	12,  // 28: Enum.Enumerants:type_name -> Enumerant
-	8,   // 29: Enum.Reserved:type_name -> ReservedRange
-	48,  // 30: Enum.CommentBlock:type_name -> CommentBlock
-	21,  // 31: Enum.AnnotationApplications:type_name -> AnnotationApplication
-	47,  // 32: Enumerant.Reference:type_name -> AttributeReference
-	48,  // 33: Enumerant.CommentBlock:type_name -> CommentBlock
-	21,  // 34: Enumerant.AnnotationApplications:type_name -> AnnotationApplication
-	42,  // 35: API.Reference:type_name -> TypeReference
-	49,  // 36: API.Name:type_name -> TypeName
-	14,  // 37: API.Methods:type_name -> APIMethod
-	43,  // 38: API.Extends:type_name -> TypeSpecifier
-	8,   // 39: API.Reserved:type_name -> ReservedRange
-	48,  // 40: API.CommentBlock:type_name -> CommentBlock
-	21,  // 41: API.AnnotationApplications:type_name -> AnnotationApplication
-	47,  // 42: APIMethod.Reference:type_name -> AttributeReference
-	43,  // 43: APIMethod.Input:type_name -> TypeSpecifier
-	43,  // 44: APIMethod.Output:type_name -> TypeSpecifier
-	48,  // 45: APIMethod.CommentBlock:type_name -> CommentBlock
-	21,  // 46: APIMethod.AnnotationApplications:type_name -> AnnotationApplication
-	42,  // 47: SDK.Reference:type_name -> TypeReference
-	49,  // 48: SDK.Name:type_name -> TypeName
-	16,  // 49: SDK.Methods:type_name -> SDKMethod
-	43,  // 50: SDK.Extends:type_name -> TypeSpecifier
-	8,   // 51: SDK.Reserved:type_name -> ReservedRange
-	48,  // 52: SDK.CommentBlock:type_name -> CommentBlock
-	21,  // 53: SDK.AnnotationApplications:type_name -> AnnotationApplication
-	47,  // 54: SDKMethod.Reference:type_name -> AttributeReference
-	17,  // 55: SDKMethod.Input:type_name -> SDKMethodInput
-	43,  // 56: SDKMethod.Output:type_name -> TypeSpecifier
-	48,  // 57: SDKMethod.CommentBlock:type_name -> CommentBlock
-	21,  // 58: SDKMethod.AnnotationApplications:type_name -> AnnotationApplication
-	18,  // 59: SDKMethodInput.Reference:type_name -> SDKInputReference
-	43,  // 60: SDKMethodInput.Type:type_name -> TypeSpecifier
-	42,  // 61: Annotation.Reference:type_name -> TypeReference
-	0,   // 62: Annotation.Scopes:type_name -> AnnotationScope
-	43,  // 63: Annotation.Type:type_name -> TypeSpecifier
-	48,  // 64: Annotation.DescriptorCommentBlock:type_name -> CommentBlock
-	42,  // 65: Constant.Reference:type_name -> TypeReference
-	43,  // 66: Constant.Type:type_name -> TypeSpecifier
-	22,  // 67: Constant.Value:type_name -> Value
-	21,  // 68: Constant.AnnotationApplications:type_name -> AnnotationApplication
-	48,  // 69: Constant.CommentBlock:type_name -> CommentBlock
-	43,  // 70: AnnotationApplication.Annotation:type_name -> TypeSpecifier
-	22,  // 71: AnnotationApplication.Value:type_name -> Value
-	23,  // 72: Value.Bool:type_name -> ValueBool
-	24,  // 73: Value.Text:type_name -> ValueText
-	25,  // 74: Value.Data:type_name -> ValueData
-	26,  // 75: Value.Int8:type_name -> ValueInt8
-	27,  // 76: Value.Int16:type_name -> ValueInt16
-	28,  // 77: Value.Int32:type_name -> ValueInt32
-	29,  // 78: Value.Int64:type_name -> ValueInt64
-	30,  // 79: Value.UInt8:type_name -> ValueUInt8
-	31,  // 80: Value.UInt16:type_name -> ValueUInt16
-	32,  // 81: Value.UInt32:type_name -> ValueUInt32
-	33,  // 82: Value.UInt64:type_name -> ValueUInt64
-	34,  // 83: Value.Float32:type_name -> ValueFloat32
-	35,  // 84: Value.Float64:type_name -> ValueFloat64
-	37,  // 85: Value.List:type_name -> ValueList
-	38,  // 86: Value.Struct:type_name -> ValueStruct
-	47,  // 87: Value.Enumerant:type_name -> AttributeReference
-	36,  // 88: Value.Identifier:type_name -> ValueIdentifier
-	40,  // 89: Value.Unary:type_name -> ValueUnary
-	41,  // 90: Value.Binary:type_name -> ValueBinary
-	42,  // 91: ValueIdentifier.Type:type_name -> TypeReference
-	47,  // 92: ValueIdentifier.Attribute:type_name -> AttributeReference
-	22,  // 93: ValueList.Elements:type_name -> Value
-	39,  // 94: ValueStruct.Fields:type_name -> ValueStructField
-	22,  // 95: ValueStructField.Value:type_name -> Value
-	1,   // 96: ValueUnary.Operation:type_name -> OperationUnary
-	22,  // 97: ValueUnary.Value:type_name -> Value
-	2,   // 98: ValueBinary.Operation:type_name -> OperationBinary
-	22,  // 99: ValueBinary.Left:type_name -> Value
-	22,  // 100: ValueBinary.Right:type_name -> Value
-	44,  // 101: TypeSpecifier.Forward:type_name -> ForwardReference
-	46,  // 102: TypeSpecifier.Resolved:type_name -> ResolvedReference
-	45,  // 103: ForwardReference.Microglot:type_name -> MicroglotForwardReference
-	49,  // 104: MicroglotForwardReference.Name:type_name -> TypeName
-	42,  // 105: ResolvedReference.Reference:type_name -> TypeReference
-	43,  // 106: ResolvedReference.Parameters:type_name -> TypeSpecifier
-	43,  // 107: TypeName.Parameters:type_name -> TypeSpecifier
-	108, // [108:108] is the sub-list for method output_type
-	108, // [108:108] is the sub-list for method input_type
-	108, // [108:108] is the sub-list for extension type_name
-	108, // [108:108] is the sub-list for extension extendee
-	0,   // [0:108] is the sub-list for field type_name
+	10,  // 10: Module.Extensions:type_name -> Extension
+	52,  // 11: Import.CommentBlock:type_name -> CommentBlock
+	57,  // 12: Import.Location:type_name -> SourceLocation
+	46,  // 13: DotImport.Reference:type_name -> TypeReference
+	46,  // 14: Struct.Reference:type_name -> TypeReference
+	53,  // 15: Struct.Name:type_name -> TypeName
+	11,  // 16: Struct.Fields:type_name -> Field
+	12,  // 17: Struct.Unions:type_name -> Union
+	8,   // 18: Struct.Reserved:type_name -> ReservedRange
+	52,  // 19: Struct.CommentBlock:type_name -> CommentBlock
+	23,  // 20: Struct.AnnotationApplications:type_name -> AnnotationApplication
+	57,  // 21: Struct.Location:type_name -> SourceLocation
+	9,   // 22: Struct.ExtensionRanges:type_name -> ExtensionRange
+	10,  // 23: Struct.Extensions:type_name -> Extension
+	57,  // 24: ExtensionRange.Location:type_name -> SourceLocation
+	47,  // 25: Extension.Extendee:type_name -> TypeSpecifier
+	11,  // 26: Extension.Field:type_name -> Field
+	51,  // 27: Field.Reference:type_name -> AttributeReference
+	47,  // 28: Field.Type:type_name -> TypeSpecifier
+	24,  // 29: Field.DefaultValue:type_name -> Value
+	52,  // 30: Field.CommentBlock:type_name -> CommentBlock
+	23,  // 31: Field.AnnotationApplications:type_name -> AnnotationApplication
+	57,  // 32: Field.Location:type_name -> SourceLocation
+	51,  // 33: Union.Reference:type_name -> AttributeReference
+	52,  // 34: Union.CommentBlock:type_name -> CommentBlock
+	23,  // 35: Union.AnnotationApplications:type_name -> AnnotationApplication
+	57,  // 36: Union.Location:type_name -> SourceLocation
+	46,  // 37: Enum.Reference:type_name -> TypeReference
+	14,  // 38: Enum.Enumerants:type_name -> Enumerant
+	8,   // 39: Enum.Reserved:type_name -> ReservedRange
+	52,  // 40: Enum.CommentBlock:type_name -> CommentBlock
+	23,  // 41: Enum.AnnotationApplications:type_name -> AnnotationApplication
+	57,  // 42: Enum.Location:type_name -> SourceLocation
+	51,  // 43: Enumerant.Reference:type_name -> AttributeReference
+	52,  // 44: Enumerant.CommentBlock:type_name -> CommentBlock
+	23,  // 45: Enumerant.AnnotationApplications:type_name -> AnnotationApplication
+	57,  // 46: Enumerant.Location:type_name -> SourceLocation
+	46,  // 47: API.Reference:type_name -> TypeReference
+	53,  // 48: API.Name:type_name -> TypeName
+	16,  // 49: API.Methods:type_name -> APIMethod
+	47,  // 50: API.Extends:type_name -> TypeSpecifier
+	8,   // 51: API.Reserved:type_name -> ReservedRange
+	52,  // 52: API.CommentBlock:type_name -> CommentBlock
+	23,  // 53: API.AnnotationApplications:type_name -> AnnotationApplication
+	57,  // 54: API.Location:type_name -> SourceLocation
+	51,  // 55: APIMethod.Reference:type_name -> AttributeReference
+	47,  // 56: APIMethod.Input:type_name -> TypeSpecifier
+	47,  // 57: APIMethod.Output:type_name -> TypeSpecifier
+	52,  // 58: APIMethod.CommentBlock:type_name -> CommentBlock
+	23,  // 59: APIMethod.AnnotationApplications:type_name -> AnnotationApplication
+	57,  // 60: APIMethod.Location:type_name -> SourceLocation
+	46,  // 61: SDK.Reference:type_name -> TypeReference
+	53,  // 62: SDK.Name:type_name -> TypeName
+	18,  // 63: SDK.Methods:type_name -> SDKMethod
+	47,  // 64: SDK.Extends:type_name -> TypeSpecifier
+	8,   // 65: SDK.Reserved:type_name -> ReservedRange
+	52,  // 66: SDK.CommentBlock:type_name -> CommentBlock
+	23,  // 67: SDK.AnnotationApplications:type_name -> AnnotationApplication
+	57,  // 68: SDK.Location:type_name -> SourceLocation
+	51,  // 69: SDKMethod.Reference:type_name -> AttributeReference
+	19,  // 70: SDKMethod.Input:type_name -> SDKMethodInput
+	47,  // 71: SDKMethod.Output:type_name -> TypeSpecifier
+	52,  // 72: SDKMethod.CommentBlock:type_name -> CommentBlock
+	23,  // 73: SDKMethod.AnnotationApplications:type_name -> AnnotationApplication
+	57,  // 74: SDKMethod.Location:type_name -> SourceLocation
+	20,  // 75: SDKMethodInput.Reference:type_name -> SDKInputReference
+	47,  // 76: SDKMethodInput.Type:type_name -> TypeSpecifier
+	57,  // 77: SDKMethodInput.Location:type_name -> SourceLocation
+	46,  // 78: Annotation.Reference:type_name -> TypeReference
+	0,   // 79: Annotation.Scopes:type_name -> AnnotationScope
+	47,  // 80: Annotation.Type:type_name -> TypeSpecifier
+	52,  // 81: Annotation.DescriptorCommentBlock:type_name -> CommentBlock
+	57,  // 82: Annotation.Location:type_name -> SourceLocation
+	46,  // 83: Constant.Reference:type_name -> TypeReference
+	47,  // 84: Constant.Type:type_name -> TypeSpecifier
+	24,  // 85: Constant.Value:type_name -> Value
+	23,  // 86: Constant.AnnotationApplications:type_name -> AnnotationApplication
+	52,  // 87: Constant.CommentBlock:type_name -> CommentBlock
+	57,  // 88: Constant.Location:type_name -> SourceLocation
+	47,  // 89: AnnotationApplication.Annotation:type_name -> TypeSpecifier
+	24,  // 90: AnnotationApplication.Value:type_name -> Value
+	57,  // 91: AnnotationApplication.Location:type_name -> SourceLocation
+	25,  // 92: Value.Bool:type_name -> ValueBool
+	26,  // 93: Value.Text:type_name -> ValueText
+	27,  // 94: Value.Data:type_name -> ValueData
+	28,  // 95: Value.Int8:type_name -> ValueInt8
+	29,  // 96: Value.Int16:type_name -> ValueInt16
+	30,  // 97: Value.Int32:type_name -> ValueInt32
+	31,  // 98: Value.Int64:type_name -> ValueInt64
+	32,  // 99: Value.UInt8:type_name -> ValueUInt8
+	33,  // 100: Value.UInt16:type_name -> ValueUInt16
+	34,  // 101: Value.UInt32:type_name -> ValueUInt32
+	35,  // 102: Value.UInt64:type_name -> ValueUInt64
+	36,  // 103: Value.Float32:type_name -> ValueFloat32
+	37,  // 104: Value.Float64:type_name -> ValueFloat64
+	39,  // 105: Value.List:type_name -> ValueList
+	40,  // 106: Value.Map:type_name -> ValueMap
+	42,  // 107: Value.Struct:type_name -> ValueStruct
+	51,  // 108: Value.Enumerant:type_name -> AttributeReference
+	38,  // 109: Value.Identifier:type_name -> ValueIdentifier
+	44,  // 110: Value.Unary:type_name -> ValueUnary
+	45,  // 111: Value.Binary:type_name -> ValueBinary
+	57,  // 112: Value.Location:type_name -> SourceLocation
+	46,  // 113: ValueIdentifier.Type:type_name -> TypeReference
+	51,  // 114: ValueIdentifier.Attribute:type_name -> AttributeReference
+	24,  // 115: ValueList.Elements:type_name -> Value
+	41,  // 116: ValueMap.Entries:type_name -> ValueMapEntry
+	24,  // 117: ValueMapEntry.Key:type_name -> Value
+	24,  // 118: ValueMapEntry.Value:type_name -> Value
+	43,  // 119: ValueStruct.Fields:type_name -> ValueStructField
+	24,  // 120: ValueStructField.Value:type_name -> Value
+	1,   // 121: ValueUnary.Operation:type_name -> OperationUnary
+	24,  // 122: ValueUnary.Value:type_name -> Value
+	2,   // 123: ValueBinary.Operation:type_name -> OperationBinary
+	24,  // 124: ValueBinary.Left:type_name -> Value
+	24,  // 125: ValueBinary.Right:type_name -> Value
+	48,  // 126: TypeSpecifier.Forward:type_name -> ForwardReference
+	50,  // 127: TypeSpecifier.Resolved:type_name -> ResolvedReference
+	57,  // 128: TypeSpecifier.Location:type_name -> SourceLocation
+	49,  // 129: ForwardReference.Microglot:type_name -> MicroglotForwardReference
+	53,  // 130: MicroglotForwardReference.Name:type_name -> TypeName
+	46,  // 131: ResolvedReference.Reference:type_name -> TypeReference
+	47,  // 132: ResolvedReference.Parameters:type_name -> TypeSpecifier
+	47,  // 133: TypeName.Parameters:type_name -> TypeSpecifier
+	3,   // 134: PluginRequest.Image:type_name -> Image
+	56,  // 135: PluginResponse.Files:type_name -> PluginResponseFile
+	136, // [136:136] is the sub-list for method output_type
+	136, // [136:136] is the sub-list for method input_type
+	136, // [136:136] is the sub-list for extension type_name
+	136, // [136:136] is the sub-list for extension extendee
+	0,   // [0:136] is the sub-list for field type_name
 }
 
 func init() { file_descriptor_proto_init() }
@@ -4503,7 +5345,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*Field); i {
+			switch v := v.(*ExtensionRange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4515,7 +5357,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*Union); i {
+			switch v := v.(*Extension); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4527,7 +5369,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*Enum); i {
+			switch v := v.(*Field); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4539,7 +5381,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*Enumerant); i {
+			switch v := v.(*Union); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4551,7 +5393,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*API); i {
+			switch v := v.(*Enum); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4563,7 +5405,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*APIMethod); i {
+			switch v := v.(*Enumerant); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4575,7 +5417,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*SDK); i {
+			switch v := v.(*API); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4587,7 +5429,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[13].Exporter = func(v any, i int) any {
-			switch v := v.(*SDKMethod); i {
+			switch v := v.(*APIMethod); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4599,7 +5441,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[14].Exporter = func(v any, i int) any {
-			switch v := v.(*SDKMethodInput); i {
+			switch v := v.(*SDK); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4611,7 +5453,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[15].Exporter = func(v any, i int) any {
-			switch v := v.(*SDKInputReference); i {
+			switch v := v.(*SDKMethod); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4623,7 +5465,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[16].Exporter = func(v any, i int) any {
-			switch v := v.(*Annotation); i {
+			switch v := v.(*SDKMethodInput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4635,7 +5477,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[17].Exporter = func(v any, i int) any {
-			switch v := v.(*Constant); i {
+			switch v := v.(*SDKInputReference); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4647,7 +5489,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[18].Exporter = func(v any, i int) any {
-			switch v := v.(*AnnotationApplication); i {
+			switch v := v.(*Annotation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4659,7 +5501,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[19].Exporter = func(v any, i int) any {
-			switch v := v.(*Value); i {
+			switch v := v.(*Constant); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4671,7 +5513,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[20].Exporter = func(v any, i int) any {
-			switch v := v.(*ValueBool); i {
+			switch v := v.(*AnnotationApplication); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4683,7 +5525,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[21].Exporter = func(v any, i int) any {
-			switch v := v.(*ValueText); i {
+			switch v := v.(*Value); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4695,7 +5537,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[22].Exporter = func(v any, i int) any {
-			switch v := v.(*ValueData); i {
+			switch v := v.(*ValueBool); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4707,7 +5549,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[23].Exporter = func(v any, i int) any {
-			switch v := v.(*ValueInt8); i {
+			switch v := v.(*ValueText); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4719,7 +5561,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[24].Exporter = func(v any, i int) any {
-			switch v := v.(*ValueInt16); i {
+			switch v := v.(*ValueData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4731,7 +5573,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[25].Exporter = func(v any, i int) any {
-			switch v := v.(*ValueInt32); i {
+			switch v := v.(*ValueInt8); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4743,7 +5585,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[26].Exporter = func(v any, i int) any {
-			switch v := v.(*ValueInt64); i {
+			switch v := v.(*ValueInt16); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4755,7 +5597,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[27].Exporter = func(v any, i int) any {
-			switch v := v.(*ValueUInt8); i {
+			switch v := v.(*ValueInt32); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4767,7 +5609,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[28].Exporter = func(v any, i int) any {
-			switch v := v.(*ValueUInt16); i {
+			switch v := v.(*ValueInt64); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4779,7 +5621,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[29].Exporter = func(v any, i int) any {
-			switch v := v.(*ValueUInt32); i {
+			switch v := v.(*ValueUInt8); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4791,7 +5633,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[30].Exporter = func(v any, i int) any {
-			switch v := v.(*ValueUInt64); i {
+			switch v := v.(*ValueUInt16); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4803,7 +5645,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[31].Exporter = func(v any, i int) any {
-			switch v := v.(*ValueFloat32); i {
+			switch v := v.(*ValueUInt32); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4815,7 +5657,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[32].Exporter = func(v any, i int) any {
-			switch v := v.(*ValueFloat64); i {
+			switch v := v.(*ValueUInt64); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4827,7 +5669,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[33].Exporter = func(v any, i int) any {
-			switch v := v.(*ValueIdentifier); i {
+			switch v := v.(*ValueFloat32); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4839,7 +5681,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[34].Exporter = func(v any, i int) any {
-			switch v := v.(*ValueList); i {
+			switch v := v.(*ValueFloat64); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4851,7 +5693,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[35].Exporter = func(v any, i int) any {
-			switch v := v.(*ValueStruct); i {
+			switch v := v.(*ValueIdentifier); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4863,7 +5705,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[36].Exporter = func(v any, i int) any {
-			switch v := v.(*ValueStructField); i {
+			switch v := v.(*ValueList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4875,7 +5717,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[37].Exporter = func(v any, i int) any {
-			switch v := v.(*ValueUnary); i {
+			switch v := v.(*ValueMap); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4887,7 +5729,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[38].Exporter = func(v any, i int) any {
-			switch v := v.(*ValueBinary); i {
+			switch v := v.(*ValueMapEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4899,7 +5741,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[39].Exporter = func(v any, i int) any {
-			switch v := v.(*TypeReference); i {
+			switch v := v.(*ValueStruct); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4911,7 +5753,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[40].Exporter = func(v any, i int) any {
-			switch v := v.(*TypeSpecifier); i {
+			switch v := v.(*ValueStructField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4923,7 +5765,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[41].Exporter = func(v any, i int) any {
-			switch v := v.(*ForwardReference); i {
+			switch v := v.(*ValueUnary); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4935,7 +5777,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[42].Exporter = func(v any, i int) any {
-			switch v := v.(*MicroglotForwardReference); i {
+			switch v := v.(*ValueBinary); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4947,7 +5789,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[43].Exporter = func(v any, i int) any {
-			switch v := v.(*ResolvedReference); i {
+			switch v := v.(*TypeReference); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4959,7 +5801,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[44].Exporter = func(v any, i int) any {
-			switch v := v.(*AttributeReference); i {
+			switch v := v.(*TypeSpecifier); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4971,7 +5813,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[45].Exporter = func(v any, i int) any {
-			switch v := v.(*CommentBlock); i {
+			switch v := v.(*ForwardReference); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4983,6 +5825,54 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*MicroglotForwardReference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_descriptor_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*ResolvedReference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_descriptor_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*AttributeReference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_descriptor_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*CommentBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_descriptor_proto_msgTypes[50].Exporter = func(v any, i int) any {
 			switch v := v.(*TypeName); i {
 			case 0:
 				return &v.state
@@ -4994,9 +5884,57 @@ func file_descriptor_proto_init() {
 				return nil
 			}
 		}
+		file_descriptor_proto_msgTypes[51].Exporter = func(v any, i int) any {
+			switch v := v.(*PluginRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_descriptor_proto_msgTypes[52].Exporter = func(v any, i int) any {
+			switch v := v.(*PluginResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_descriptor_proto_msgTypes[53].Exporter = func(v any, i int) any {
+			switch v := v.(*PluginResponseFile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_descriptor_proto_msgTypes[54].Exporter = func(v any, i int) any {
+			switch v := v.(*SourceLocation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_descriptor_proto_msgTypes[6].OneofWrappers = []any{}
-	file_descriptor_proto_msgTypes[19].OneofWrappers = []any{
+	file_descriptor_proto_msgTypes[8].OneofWrappers = []any{}
+	file_descriptor_proto_msgTypes[21].OneofWrappers = []any{
 		(*Value_Bool)(nil),
 		(*Value_Text)(nil),
 		(*Value_Data)(nil),
@@ -5011,21 +5949,22 @@ func file_descriptor_proto_init() {
 		(*Value_Float32)(nil),
 		(*Value_Float64)(nil),
 		(*Value_List)(nil),
+		(*Value_Map)(nil),
 		(*Value_Struct)(nil),
 		(*Value_Enumerant)(nil),
 		(*Value_Identifier)(nil),
 		(*Value_Unary)(nil),
 		(*Value_Binary)(nil),
 	}
-	file_descriptor_proto_msgTypes[33].OneofWrappers = []any{
+	file_descriptor_proto_msgTypes[35].OneofWrappers = []any{
 		(*ValueIdentifier_Type)(nil),
 		(*ValueIdentifier_Attribute)(nil),
 	}
-	file_descriptor_proto_msgTypes[40].OneofWrappers = []any{
+	file_descriptor_proto_msgTypes[44].OneofWrappers = []any{
 		(*TypeSpecifier_Forward)(nil),
 		(*TypeSpecifier_Resolved)(nil),
 	}
-	file_descriptor_proto_msgTypes[41].OneofWrappers = []any{
+	file_descriptor_proto_msgTypes[45].OneofWrappers = []any{
 		(*ForwardReference_Microglot)(nil),
 		(*ForwardReference_Protobuf)(nil),
 	}
@@ -5035,7 +5974,7 @@ func file_descriptor_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_descriptor_proto_rawDesc,
 			NumEnums:      3,
-			NumMessages:   47,
+			NumMessages:   55,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
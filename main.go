@@ -25,6 +25,7 @@ import (
 	"gopkg.microglot.org/mglotc/internal/fs"
 	"gopkg.microglot.org/mglotc/internal/idl"
 	"gopkg.microglot.org/mglotc/internal/mglotc_gen_go"
+	mglotproto "gopkg.microglot.org/mglotc/internal/proto"
 	"gopkg.microglot.org/mglotc/internal/target"
 )
 
@@ -34,9 +35,14 @@ type opts struct {
 	DumpTokens       bool
 	DumpTree         bool
 	DescriptorSetOut string
+	ImageOut         string
 	ProtobufPlugins  []string
 	Plugins          []string
 	PerPackageMode   bool
+	UIDLock          string
+	UpdateUIDLock    bool
+	ProtobufSyntax   string
+	ProtobufEdition  string
 }
 
 var (
@@ -56,9 +62,14 @@ func main() {
 	flags.BoolVar(&op.DumpTokens, "dump-tokens", false, "Output the token stream as it is processed")
 	flags.BoolVar(&op.DumpTree, "dump-tree", false, "Output the parse tree after parsing")
 	flags.StringVar(&op.DescriptorSetOut, "descriptor_set_out", "", "Writes a protobuf FileDescriptorSet containing all the input to FILE")
+	flags.StringVar(&op.ImageOut, "image_out", "", "Writes a microglot image containing all the input to FILE. The format is chosen by the file extension: .mglotbin, .mglotjson, or .mglotproto")
 	flags.StringSliceVar(&op.ProtobufPlugins, "pbplugin", []string{}, "Specifies a protobuf plugin executable to use.")
 	flags.StringSliceVar(&op.Plugins, "plugin", []string{}, "Specifies a plugin executable to use.")
 	flags.BoolVar(&op.PerPackageMode, "per-package-mode", false, "Enable per-package mode for legacy protoc plugins that don't support multi-package builds.")
+	flags.StringVar(&op.UIDLock, "uid-lock", "", "Records generated UIDs in FILE, which is created if it doesn't exist, and fails if any recorded UID would change.")
+	flags.BoolVar(&op.UpdateUIDLock, "update-uid-lock", false, "Accepts changes to the UIDs recorded by --uid-lock and updates the lock to match.")
+	flags.StringVar(&op.ProtobufSyntax, "protobuf-syntax", "", "Converts microglot modules to protobuf with SYNTAX, which is proto2 or proto3, in place of the syntax they choose.")
+	flags.StringVar(&op.ProtobufEdition, "protobuf-edition", "", "Converts microglot modules to protobuf with EDITION, such as 2023, in place of the syntax they choose.")
 	_ = flags.Parse(os.Args[1:])
 	targets := flags.Args()
 	for x, t := range targets {
@@ -89,12 +100,27 @@ func main() {
 	}
 	mf = append(mf, f)
 
+	var uidLock *compiler.UIDLock
+	if op.UIDLock != "" {
+		uidLock, err = readUIDLock(op.UIDLock)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err.Error())
+			os.Exit(1)
+		}
+	} else if op.UpdateUIDLock {
+		fmt.Fprintln(os.Stderr, "--update-uid-lock requires --uid-lock")
+		os.Exit(1)
+	}
+
 	c, err := compiler.New(
 		compiler.OptionWithLookupEnv(os.LookupEnv),
 		compiler.OptionWithFS(mf),
+		compiler.OptionWithUIDLock(uidLock, op.UpdateUIDLock),
+		compiler.OptionWithProtobufSyntax(op.ProtobufSyntax, op.ProtobufEdition),
 	)
 	if err != nil {
-		panic(err)
+		fmt.Fprintln(os.Stderr, err.Error())
+		os.Exit(1)
 	}
 
 	out, err := c.Compile(ctx, &idl.CompileRequest{
@@ -113,6 +139,18 @@ func main() {
 		panic(err)
 	}
 
+	if uidLock != nil && uidLock.Changed() {
+		bytes, err := uidLock.Marshal()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err.Error())
+			os.Exit(1)
+		}
+		if err = os.WriteFile(op.UIDLock, bytes, 0o644); err != nil {
+			fmt.Fprintln(os.Stderr, err.Error())
+			os.Exit(1)
+		}
+	}
+
 	if op.DescriptorSetOut != "" {
 		fds, err := out.Image.ToFileDescriptorSet()
 		if err != nil {
@@ -131,6 +169,19 @@ func main() {
 		}
 	}
 
+	if op.ImageOut != "" {
+		bytes, err := out.Image.Marshal(fs.KindOf(op.ImageOut))
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err.Error())
+			os.Exit(1)
+		}
+
+		if err = os.WriteFile(op.ImageOut, bytes, 0o644); err != nil {
+			fmt.Fprintln(os.Stderr, err.Error())
+			os.Exit(1)
+		}
+	}
+
 	for _, plugin := range op.ProtobufPlugins {
 		binary, parameters, _ := strings.Cut(plugin, ":")
 
@@ -184,28 +235,14 @@ func main() {
 				os.Exit(1)
 			}
 
-			var pluginOut bytes.Buffer
-			var pluginErr bytes.Buffer
-
-			binary, err = exec.LookPath(binary)
-			if err != nil {
-				fmt.Fprintln(os.Stderr, err.Error())
-				os.Exit(1)
-			}
-			cmd := exec.Command(binary)
-			cmd.Stdin = bytes.NewReader(requestBytes)
-			cmd.Stdout = &pluginOut
-			cmd.Stderr = &pluginErr
-
-			err = cmd.Run()
+			pluginOut, err := runPlugin(binary, requestBytes)
 			if err != nil {
-				fmt.Fprintf(os.Stderr, "%s", pluginErr.String())
 				fmt.Fprintln(os.Stderr, err.Error())
 				os.Exit(1)
 			}
 
 			response := pluginpb.CodeGeneratorResponse{}
-			err = proto.Unmarshal(pluginOut.Bytes(), &response)
+			err = proto.Unmarshal(pluginOut, &response)
 			if err != nil {
 				fmt.Fprintln(os.Stderr, err.Error())
 				os.Exit(1)
@@ -234,21 +271,39 @@ func main() {
 	for _, plugin := range op.Plugins {
 		name, parameters, _ := strings.Cut(plugin, ":")
 
-		if name != "mglotc-gen-go" {
-			fmt.Fprintf(os.Stderr, "Only the mglotc-gen-go plugin is supported, for now (%s)\n", name)
-			os.Exit(1)
-		}
+		var files []*pluginpb.CodeGeneratorResponse_File
+		if name == "mglotc-gen-go" {
+			g, err := mglotc_gen_go.NewGenerator(parameters, out.Image)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err.Error())
+				os.Exit(1)
+			}
+			files, err = g.Generate(targets)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err.Error())
+				os.Exit(1)
+			}
+		} else {
+			responseFiles, err := runNativePlugin(name, &mglotproto.PluginRequest{
+				Image: &mglotproto.Image{
+					Modules: out.Image.Modules,
+				},
+				FilesToGenerate: targets,
+				Parameter:       parameters,
+				CompilerVersion: version,
+			})
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err.Error())
+				os.Exit(1)
+			}
 
-		// TODO 2023.12.30: an executable interface like the protobuf one, but passing an idl.Image
-		g, err := mglotc_gen_go.NewGenerator(parameters, out.Image)
-		if err != nil {
-			fmt.Fprintln(os.Stderr, err.Error())
-			os.Exit(1)
-		}
-		files, err := g.Generate(targets)
-		if err != nil {
-			fmt.Fprintln(os.Stderr, err.Error())
-			os.Exit(1)
+			for _, responseFile := range responseFiles {
+				content := string(responseFile.Content)
+				files = append(files, &pluginpb.CodeGeneratorResponse_File{
+					Name:    &responseFile.Name,
+					Content: &content,
+				})
+			}
 		}
 
 		for _, responseFile := range files {
@@ -264,3 +319,68 @@ func main() {
 		}
 	}
 }
+
+// readUIDLock loads the UID lock at the given path, or returns an empty lock if
+// there isn't one yet.
+func readUIDLock(name string) (*compiler.UIDLock, error) {
+	b, err := os.ReadFile(name)
+	if errors.Is(err, os.ErrNotExist) {
+		return compiler.NewUIDLock(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	lock, err := compiler.ParseUIDLock(b)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return lock, nil
+}
+
+// runNativePlugin exchanges a PluginRequest for the files of a PluginResponse
+// with a native plugin executable, which must be named mglotc-gen-*. A
+// response that carries an Error is returned as an error.
+func runNativePlugin(name string, request *mglotproto.PluginRequest) ([]*mglotproto.PluginResponseFile, error) {
+	if !strings.HasPrefix(path.Base(name), "mglotc-gen-") {
+		return nil, fmt.Errorf("Plugin executables must be named mglotc-gen-* (%s)", name)
+	}
+	requestBytes, err := proto.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	pluginOut, err := runPlugin(name, requestBytes)
+	if err != nil {
+		return nil, err
+	}
+	response := mglotproto.PluginResponse{}
+	if err = proto.Unmarshal(pluginOut, &response); err != nil {
+		return nil, err
+	}
+	if response.Error != "" {
+		return nil, errors.New(response.Error)
+	}
+	return response.Files, nil
+}
+
+// runPlugin executes a plugin binary found on the PATH, writes the encoded
+// request to its stdin, and returns the content of its stdout. Both the protoc
+// and the native plugin protocols use this same exchange.
+func runPlugin(binary string, request []byte) ([]byte, error) {
+	var pluginOut bytes.Buffer
+	var pluginErr bytes.Buffer
+
+	binary, err := exec.LookPath(binary)
+	if err != nil {
+		return nil, err
+	}
+	cmd := exec.Command(binary)
+	cmd.Stdin = bytes.NewReader(request)
+	cmd.Stdout = &pluginOut
+	cmd.Stderr = &pluginErr
+
+	err = cmd.Run()
+	if err != nil {
+		return nil, fmt.Errorf("%s%w", pluginErr.String(), err)
+	}
+	return pluginOut.Bytes(), nil
+}
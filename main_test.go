@@ -0,0 +1,84 @@
+// © 2023 Microglot LLC
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	mglotproto "gopkg.microglot.org/mglotc/internal/proto"
+)
+
+func TestRunNativePlugin(t *testing.T) {
+	t.Parallel()
+	if runtime.GOOS == "windows" {
+		t.Skip("the stub plugin is a shell script")
+	}
+
+	// stubPlugin writes an executable with the given name that saves the
+	// request it's given and answers with the given response.
+	stubPlugin := func(t *testing.T, name string, response *mglotproto.PluginResponse) (string, string) {
+		dir := t.TempDir()
+		b, err := proto.Marshal(response)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "response"), b, 0o644))
+		plugin := filepath.Join(dir, name)
+		script := "#!/bin/sh\ncat > \"" + filepath.Join(dir, "request") + "\"\ncat \"" + filepath.Join(dir, "response") + "\"\n"
+		require.NoError(t, os.WriteFile(plugin, []byte(script), 0o755))
+		return plugin, filepath.Join(dir, "request")
+	}
+	request := &mglotproto.PluginRequest{
+		Image:           &mglotproto.Image{Modules: []*mglotproto.Module{{URI: "/a.mglot", UID: 11}}},
+		FilesToGenerate: []string{"/a.mglot"},
+		Parameter:       "paths=source_relative",
+		CompilerVersion: "v1.2.3",
+	}
+
+	t.Run("round trip", func(t *testing.T) {
+		t.Parallel()
+		files := []*mglotproto.PluginResponseFile{
+			{Name: "a.txt", Content: []byte("text")},
+			{Name: "b/a.bin", Content: []byte{0x00, 0xff, 0xfe}},
+		}
+		plugin, saved := stubPlugin(t, "mglotc-gen-stub", &mglotproto.PluginResponse{Files: files})
+		got, err := runNativePlugin(plugin, request)
+		require.NoError(t, err)
+		require.Len(t, got, len(files))
+		for x, file := range files {
+			require.True(t, proto.Equal(file, got[x]), "%v != %v", file, got[x])
+		}
+
+		b, err := os.ReadFile(saved)
+		require.NoError(t, err)
+		var received mglotproto.PluginRequest
+		require.NoError(t, proto.Unmarshal(b, &received))
+		require.True(t, proto.Equal(request, &received), "%v != %v", request, &received)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		t.Parallel()
+		plugin, _ := stubPlugin(t, "mglotc-gen-stub", &mglotproto.PluginResponse{
+			Error: "no output for you",
+			Files: []*mglotproto.PluginResponseFile{{Name: "a.txt", Content: []byte("text")}},
+		})
+		got, err := runNativePlugin(plugin, request)
+		require.EqualError(t, err, "no output for you")
+		require.Nil(t, got)
+	})
+
+	t.Run("name", func(t *testing.T) {
+		t.Parallel()
+		plugin, saved := stubPlugin(t, "protoc-gen-stub", &mglotproto.PluginResponse{})
+		_, err := runNativePlugin(plugin, request)
+		require.EqualError(t, err, "Plugin executables must be named mglotc-gen-* ("+plugin+")")
+		_, err = os.Stat(saved)
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+}